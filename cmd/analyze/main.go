@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// The analyze command solves a 3x3 position with perfect play. It prints the value of the
+// position for the player to move, the board with the value of the best move of each empty
+// cell, and the value of every legal move.
+//
+// Usage:
+//
+//	analyze [flags] <position>
+//
+// The position is written row by row from the top left, as "X.O/.X./..O", optionally followed
+// by the player to move, as "X.O/.X./..O:O".
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"GoRythm/internal/log"
+	"GoRythm/internal/rules"
+	"GoRythm/internal/solver"
+)
+
+func main() {
+	misere := flag.Bool("misere", false, "Solve the misère variant")
+	wild := flag.Bool("wild", false, "Solve the Wild variant")
+	notakto := flag.Bool("notakto", false, "Solve the Notakto variant")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "Usage: analyze [flags] <position>")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	variant := rules.Variant{Misere: *misere, Wild: *wild, Notakto: *notakto}.Normalize()
+	board, turn, err := solver.ParsePosition(flag.Arg(0), variant)
+	if err != nil {
+		log.LogMessage(log.FATAL, err.Error())
+	}
+	s, err := solver.New(variant)
+	if err != nil {
+		log.LogMessage(log.FATAL, err.Error())
+	}
+	value, err := s.Value(board, turn)
+	if err != nil {
+		log.LogMessage(log.FATAL, fmt.Sprintf("%s with the %s rules: %s", flag.Arg(0), variant, err.Error()))
+	}
+	moves, err := s.Moves(board, turn)
+	if err != nil {
+		log.LogMessage(log.FATAL, err.Error())
+	}
+
+	fmt.Printf("%s rules, %s to move: %s\n\n", variant, turn, value)
+	fmt.Println(drawBoard(board, moves))
+	for _, m := range moves {
+		fmt.Printf("(%d, %d) %s: %s\n", m.Move.X, m.Move.Y, m.Move.Symbol, m.Value)
+	}
+}
+
+// drawBoard returns the board with the symbols and the value of the best move of each empty
+// cell, in lower case.
+func drawBoard(board rules.Board, moves []solver.MoveValue) string {
+	cells := map[[2]int]string{}
+	// The moves are sorted, the first move of a cell is its best one
+	for _, m := range moves {
+		cell := [2]int{m.Move.X, m.Move.Y}
+		if _, ok := cells[cell]; !ok {
+			cells[cell] = strings.ToLower(m.Value.Short())
+		}
+	}
+	var rows []string
+	for y := 0; y < 3; y++ {
+		var row []string
+		for x := 0; x < 3; x++ {
+			cell := string(board[x][y])
+			if cell == "" {
+				cell = cells[[2]int{x, y}]
+			}
+			if cell == "" {
+				cell = "."
+			}
+			row = append(row, fmt.Sprintf("%-3s", cell))
+		}
+		rows = append(rows, " "+strings.Join(row, "| "))
+	}
+	return strings.Join(rows, "\n"+strings.Repeat("-", 15)+"\n")
+}
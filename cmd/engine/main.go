@@ -0,0 +1,39 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// The engine command is the reference engine of the engine protocol. It plays the 3x3 games
+// with a registered strategy, reading the commands of the game on its standard input and
+// writing its answers on its standard output.
+//
+// Usage:
+//
+//	engine [flags]
+package main
+
+import (
+	"flag"
+	"math/rand"
+	"os"
+	"time"
+
+	"GoRythm/internal/ai"
+	"GoRythm/internal/engine"
+	"GoRythm/internal/log"
+)
+
+func main() {
+	strategy := flag.String("strategy", "minimax", "Strategy played by the engine")
+	seed := flag.Int64("seed", 0, "Seed of the random moves (0 for the current time)")
+	flag.Parse()
+
+	s, err := ai.New(*strategy)
+	if err != nil {
+		log.LogMessage(log.FATAL, err.Error())
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	if err := engine.Serve(*strategy, s, os.Stdin, os.Stdout, rand.New(rand.NewSource(*seed))); err != nil {
+		log.LogMessage(log.FATAL, err.Error())
+	}
+}
@@ -6,11 +6,15 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	_ "image/png"
 
 	"GoRythm/game"
 	a "GoRythm/internal/audio"
+	"GoRythm/internal/engine"
 	"GoRythm/internal/log"
+	"GoRythm/internal/settings"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/audio"
@@ -23,19 +27,55 @@ const (
 )
 
 func main() {
+	if err := run(); err != nil {
+		log.LogMessage(log.FATAL, err.Error())
+	}
+}
+
+// run runs the game with the flags. The external engine is stopped before it returns.
+func run() error {
+	server := flag.String("server", "", "Address of the server for the Online mode (host:port)")
+	discovery := flag.String("discovery", "", "UDP address the games are announced on in the lobby")
+	engineCommand := flag.String("engine", "", "Command line of an external engine watched in the Watch mode")
+	flag.Parse()
+
+	// Start the external engine, registered as an AI strategy
+	if *engineCommand != "" {
+		e, err := engine.Start(*engineCommand)
+		if err != nil {
+			return fmt.Errorf("failed to start the engine: %w", err)
+		}
+		defer e.Close()
+		log.LogMessage(log.INFO, "Engine registered as "+e.Register())
+	}
+
 	audioContext := audio.NewContext(a.SampleRate) // Initialize the audio context once
 
+	// Load the user settings, the defaults are used on error
+	userSettings, err := settings.Load()
+	if err != nil {
+		log.LogMessage(log.ERROR, "Failed to load the settings: "+err.Error())
+	}
+
 	// Initialize the game
 	game := game.NewGame()
-	err := game.Init(audioContext, sWidth, sHeight)
+	err = game.Init(audioContext, sWidth, sHeight)
 	if err != nil {
-		log.LogMessage(log.FATAL, "Failed to initialize the game: "+err.Error())
+		return fmt.Errorf("failed to initialize the game: %w", err)
 	}
 	ebiten.SetWindowSize(sWidth, sHeight)
 	ebiten.SetWindowTitle(title)
+	game.ApplySettings(userSettings)
+	if *server != "" {
+		game.SetServerAddress(*server)
+	}
+	if *discovery != "" {
+		game.SetDiscoveryAddress(*discovery)
+	}
 
 	// Run the game
 	if err := ebiten.RunGame(game); err != nil {
-		log.LogMessage(log.FATAL, "Failed to run the game: "+err.Error())
+		return fmt.Errorf("failed to run the game: %w", err)
 	}
+	return nil
 }
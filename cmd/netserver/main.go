@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// The netserver command hosts online two-player matches of GoRythm. The clients connect
+// to it with the Online mode of the game and are paired two by two. The server is announced
+// on the local network so it is listed in the lobby of the game.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"GoRythm/internal/discovery"
+	"GoRythm/internal/log"
+	"GoRythm/internal/network"
+	"GoRythm/internal/rhythm"
+)
+
+func main() {
+	addr := flag.String("addr", fmt.Sprintf(":%d", network.DefaultPort), "Address to listen on")
+	mode := flag.String("mode", string(network.GoRythmMode), "Rules of the matches (classic or gorythm)")
+	name := flag.String("name", hostname(), "Name of the server in the lobby")
+	announce := flag.String("announce", discovery.DefaultGroup, "UDP address to announce the server on (empty to disable)")
+	flag.Parse()
+
+	if network.Mode(*mode) != network.ClassicMode && network.Mode(*mode) != network.GoRythmMode {
+		log.LogMessage(log.FATAL, "Unknown mode: "+*mode)
+	}
+	beatMap, err := rhythm.LoadBeatmap()
+	if err != nil {
+		log.LogMessage(log.FATAL, "Failed to load beatmap: "+err.Error())
+	}
+
+	server := network.NewServer(network.Mode(*mode), beatMap)
+	if err := server.Listen(*addr); err != nil {
+		log.LogMessage(log.FATAL, err.Error())
+	}
+	log.LogMessage(log.INFO, fmt.Sprintf("Hosting %s matches on %s", *mode, server.Addr()))
+	if *announce != "" {
+		announcer, err := discovery.NewAnnouncer(*announce, func() discovery.Announcement {
+			return discovery.ServerAnnouncement(*name, server)
+		})
+		if err != nil {
+			log.LogMessage(log.FATAL, err.Error())
+		}
+		announcer.Start()
+		defer announcer.Close()
+		log.LogMessage(log.INFO, fmt.Sprintf("Announcing %s on %s", *name, *announce))
+	}
+	if err := server.Serve(); err != nil {
+		log.LogMessage(log.FATAL, "Server stopped: "+err.Error())
+	}
+}
+
+// hostname returns the name of the machine, used as the default name of the server.
+func hostname() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return "GoRythm"
+	}
+	return name
+}
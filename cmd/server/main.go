@@ -0,0 +1,35 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// The server command hosts games of GoRythm over a REST API with JSON bodies: the classic game
+// with its rule variants, Ultimate Tic-Tac-Toe and the 3D cube, with moves of the AI strategies.
+// The games are kept in memory. The routes are described in the api package.
+package main
+
+import (
+	"flag"
+	"net/http"
+	"time"
+
+	"GoRythm/internal/api"
+	"GoRythm/internal/log"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "Address to listen on")
+	seed := flag.Int64("seed", 0, "Seed of the AI moves (0 for the current time)")
+	flag.Parse()
+
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           api.NewServer(*seed).Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	log.LogMessage(log.INFO, "Serving the API on http://"+*addr)
+	if err := server.ListenAndServe(); err != nil {
+		log.LogMessage(log.FATAL, "Server stopped: "+err.Error())
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// The tournament command runs a headless round-robin tournament between the AI strategies of
+// GoRythm and prints the win/draw/loss tables of each mode with the Elo estimates.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"GoRythm/internal/ai"
+	"GoRythm/internal/engine"
+	"GoRythm/internal/log"
+	"GoRythm/internal/rules"
+	"GoRythm/internal/tournament"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.LogMessage(log.FATAL, err.Error())
+	}
+}
+
+// run plays the tournament of the flags. The engines are stopped before it returns.
+func run() error {
+	players := flag.String("ai", "", "Comma-separated strategies playing the tournament (all the registered strategies by default)")
+	modes := flag.String("modes", "classic", "Comma-separated modes played (classic, ultimate, cube3 or cube4)")
+	games := flag.Int("games", 10, "Number of games of each pair of strategies in each mode")
+	seed := flag.Int64("seed", 1, "Seed of the first game")
+	maxMoves := flag.Int("max-moves", tournament.DefaultMaxMoves, "Number of moves after which a game is a draw")
+	misere := flag.Bool("misere", false, "Play the classic games with the misère variant")
+	wild := flag.Bool("wild", false, "Play the classic games with the Wild variant")
+	notakto := flag.Bool("notakto", false, "Play the classic games with the Notakto variant")
+	maxSymbols := flag.Int("max-symbols", 0, "Maximum number of symbols per player in the classic games (0 for no limit)")
+	engines := flag.String("engine", "", "Semicolon-separated command lines of external engines playing the classic games")
+	flag.Parse()
+
+	for _, command := range strings.Split(*engines, ";") {
+		if strings.TrimSpace(command) == "" {
+			continue
+		}
+		e, err := engine.Start(command)
+		if err != nil {
+			return fmt.Errorf("failed to start the engine: %w", err)
+		}
+		defer e.Close()
+		log.LogMessage(log.INFO, "Engine registered as "+e.Register())
+	}
+	if *players == "" {
+		*players = strings.Join(ai.Names(), ",")
+	}
+
+	variant := rules.Variant{Misere: *misere, Wild: *wild, Notakto: *notakto, MaxSymbols: *maxSymbols}.Normalize()
+	config := tournament.Config{
+		Players:  strings.Split(*players, ","),
+		Games:    *games,
+		Seed:     *seed,
+		MaxMoves: *maxMoves,
+	}
+	for _, name := range strings.Split(*modes, ",") {
+		mode, err := tournament.ParseMode(name, variant)
+		if err != nil {
+			return err
+		}
+		config.Modes = append(config.Modes, mode)
+	}
+
+	log.LogMessage(log.INFO, fmt.Sprintf("Playing %s on %s with the %s rules", *players, *modes, variant))
+	report, err := tournament.Run(config)
+	if err != nil {
+		return fmt.Errorf("tournament failed: %w", err)
+	}
+	return report.Write(os.Stdout)
+}
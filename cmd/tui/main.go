@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// The tui command plays the 3x3 game in a terminal, between two players sharing the keyboard
+// or against an AI strategy, so that it can be played over SSH. When the standard input is not
+// a terminal, the keys are read from it as a script and the screens are written as plain text.
+//
+// Usage:
+//
+//	tui [flags]
+package main
+
+import (
+	"flag"
+	"os"
+	"time"
+
+	"GoRythm/internal/log"
+	"GoRythm/internal/rules"
+	"GoRythm/internal/tui"
+)
+
+func main() {
+	strategy := flag.String("ai", "", "Strategy of the AI opponent, as random, level5 or minimax (empty for two players)")
+	human := flag.String("side", "X", "Symbol played against the AI (X or O)")
+	misere := flag.Bool("misere", false, "Play the misère variant")
+	wild := flag.Bool("wild", false, "Play the Wild variant")
+	notakto := flag.Bool("notakto", false, "Play the Notakto variant")
+	maxSymbols := flag.Int("max-symbols", 0, "Maximum number of symbols per player (0 for no limit)")
+	plain := flag.Bool("plain", false, "Write the screens as plain text without colors")
+	seed := flag.Int64("seed", 0, "Seed of the AI moves (0 for the current time)")
+	flag.Parse()
+
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	// The keys are read one by one from a terminal
+	restore, err := makeRaw(os.Stdin)
+	if err != nil {
+		*plain = true
+	} else {
+		defer restore()
+	}
+
+	config := tui.Config{
+		Strategy: *strategy,
+		Human:    rules.Symbol(*human),
+		Variant:  rules.Variant{Misere: *misere, Wild: *wild, Notakto: *notakto, MaxSymbols: *maxSymbols},
+		Color:    !*plain,
+		Seed:     *seed,
+	}
+	app, err := tui.New(config, os.Stdout)
+	if err == nil {
+		err = app.Run(os.Stdin)
+	}
+	if err != nil {
+		if restore != nil {
+			restore()
+		}
+		log.LogMessage(log.FATAL, err.Error())
+	}
+}
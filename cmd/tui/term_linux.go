@@ -0,0 +1,11 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import "golang.org/x/sys/unix"
+
+const (
+	ioctlGetTermios = unix.TCGETS // The request reading the terminal mode
+	ioctlSetTermios = unix.TCSETS // The request changing the terminal mode
+)
@@ -0,0 +1,17 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux && !darwin
+
+package main
+
+import (
+	"errors"
+	"os"
+)
+
+// makeRaw returns an error, the raw mode is not supported on this system. The keys are read
+// once Enter is pressed.
+func makeRaw(f *os.File) (func(), error) {
+	return nil, errors.New("raw mode not supported")
+}
@@ -3,88 +3,174 @@
 
 package game
 
-import "math"
+import (
+	"GoRythm/internal/ai"
+	"GoRythm/internal/log"
+	"GoRythm/internal/rules"
+	"context"
+	"fmt"
+	"time"
+)
 
-// EasyCpu returns a random move.
-func (g *Game) EasyCpu() (int, int) {
-	r := newRandom()
-	var x, y int
-	for {
-		x = r.Intn(3)
-		y = r.Intn(3)
-		if g.board[x][y] == NONE_PLAYING {
-			break
-		}
+const (
+	aiDeadline     = 2 * time.Second        // The time the AI has to choose its move
+	aiMinThink     = 400 * time.Millisecond // The minimum time the AI thinks before playing
+	defaultAILevel = 5                      // The skill level of the medium AI until another one is selected
+	aiFallback     = "random"               // The strategy playing the move of a strategy that failed
+)
+
+// aiStrategy returns the name of the registered strategy of the AI opponent of the game mode:
+// random moves for the easy AI, the selected skill level for the medium AI, the minimax search otherwise.
+func (g *Game) aiStrategy() string {
+	switch g.gameMode {
+	case EASY_AI_MODE:
+		return "random"
+	case MEDIUM_AI_MODE:
+		return ai.LevelName(g.aiLevel)
 	}
-	return x, y
+	return "minimax"
 }
 
-// HardCpu returns the best move for the AI by using the minimax algorithm.
-// It simulates all possible moves and passes them to the minimax function to find the best one.
-func (g *Game) HardCpu() (int, int) {
-	bestScore := math.MinInt
-	var bestMove [2]int
-	// Minimax with increased depth
-	for x := 0; x < 3; x++ {
-		for y := 0; y < 3; y++ {
-			if g.board[x][y] == NONE_PLAYING {
-				// Simulate the move
-				g.board[x][y] = X_PLAYING
-				score := g.minimax(0, false)
-				g.board[x][y] = NONE_PLAYING // Undo the move
-
-				// Keep track of the best move
-				if score > bestScore {
-					bestScore = score
-					bestMove = [2]int{x, y}
-				}
-			}
-		}
+// selectMedium selects the medium AI mode, or the next skill level when it is already selected.
+func (g *Game) selectMedium() {
+	if g.gameMode == MEDIUM_AI_MODE {
+		g.aiLevel = g.aiLevel%ai.MaxLevel + ai.MinLevel
 	}
-	return bestMove[0], bestMove[1]
+	g.gameMode = MEDIUM_AI_MODE
 }
 
-// minimax is a recursive algorithm to find the best move possible.
-// It returns the best score for the current player and board state.
-func (g *Game) minimax(depth int, isMaximizing bool) int {
-	// Check if game is over
-	winner, _ := g.checkWinBoard()
-	if winner == X_PLAYING {
-		return 10 - depth // Maximize for AI (X)
+// aiPosition returns a copy of the current game as a position of the AI strategies.
+func (g *Game) aiPosition() ai.Position {
+	switch {
+	case g.ultimate != nil:
+		clone := *g.ultimate
+		return &ai.Ultimate{Game: &clone}
+	case g.cube != nil:
+		return &ai.Cube{Game: g.cube.Clone()}
+	case g.position != nil:
+		return &ai.Classic{Game: g.position.Clone()}
 	}
-	if winner == O_PLAYING {
-		return depth - 10 // Minimize for Player (O)
+	// A board without rules is played with the classic rules
+	position := rules.NewGame(rules.Symbol(g.currentPlayerSymbol), rules.Variant{})
+	position.Board = g.rulesBoard()
+	return &ai.Classic{Game: position}
+}
+
+// An aiJob struct contains a move of the AI being computed off the game loop.
+type aiJob struct {
+	strategy string             // The strategy computing the move
+	cancel   context.CancelFunc // Stops the search of the move
+	results  chan aiResult      // Receives the move once computed
+	start    time.Time          // The time the AI started thinking
+}
+
+// An aiResult struct contains the move computed by the AI, or the error of the strategy.
+type aiResult struct {
+	move int
+	err  error
+}
+
+// startAI starts computing the move of the strategy for the current player, X or O, in a
+// goroutine stopped at aiDeadline.
+func (g *Game) startAI(strategy string) error {
+	job, err := g.newAIJob(strategy, aiDeadline)
+	if err != nil {
+		return err
 	}
-	if g.isBoardFull() {
-		return 0 // Draw
+	g.aiJob = job
+	return nil
+}
+
+// newAIJob starts computing the move of the strategy for the current player in a goroutine. The
+// goroutine plays on a copy of the current game, stopped at the deadline or when the job is
+// cancelled.
+func (g *Game) newAIJob(strategy string, deadline time.Duration) (*aiJob, error) {
+	player, err := ai.NewPlayer(strategy, newRandom())
+	if err != nil {
+		return nil, err
 	}
+	position := g.aiPosition()
+	side := rules.Symbol(g.currentPlayerSymbol)
+	ctx, cancel := context.WithTimeout(context.Background(), deadline)
+	job := &aiJob{strategy: strategy, cancel: cancel, results: make(chan aiResult, 1), start: time.Now()}
+	go func() {
+		defer cancel()
+		move, err := player.Move(ctx, position, side)
+		job.results <- aiResult{move, err}
+	}()
+	return job, nil
+}
 
-	// Maximizing Player (AI)
-	if isMaximizing {
-		bestScore := math.MinInt
-		for x := 0; x < 3; x++ {
-			for y := 0; y < 3; y++ {
-				if g.board[x][y] == NONE_PLAYING {
-					g.board[x][y] = X_PLAYING // AI's move
-					score := g.minimax(depth+1, false)
-					g.board[x][y] = NONE_PLAYING // Undo the move
-					bestScore = max(bestScore, score)
-				}
-			}
+// updateAI plays the move of the strategy for the current player once it is computed and the
+// AI has been thinking for at least the minimum time, so that its moves are not instantaneous.
+// It starts computing the move if the AI is not thinking yet, and never blocks the game loop.
+// A strategy that fails is replaced by the fallback strategy for this move.
+func (g *Game) updateAI(strategy string, minThink time.Duration) {
+	if g.aiJob == nil {
+		if err := g.startAI(strategy); err != nil {
+			log.LogMessage(log.ERROR, fmt.Sprintf("%s failed to start: %s", strategy, err.Error()))
+			g.fallbackAI(strategy)
 		}
-		return bestScore
-	} else { // Minimizing Player (Human)
-		bestScore := math.MaxInt
-		for x := 0; x < 3; x++ {
-			for y := 0; y < 3; y++ {
-				if g.board[x][y] == NONE_PLAYING {
-					g.board[x][y] = O_PLAYING // Human's move
-					score := g.minimax(depth+1, true)
-					g.board[x][y] = NONE_PLAYING // Undo the move
-					bestScore = min(bestScore, score)
-				}
-			}
+		return
+	}
+	if time.Since(g.aiJob.start) < minThink {
+		return
+	}
+	select {
+	case res := <-g.aiJob.results:
+		failed := g.aiJob.strategy
+		g.aiJob = nil
+		if res.err != nil {
+			log.LogMessage(log.ERROR, fmt.Sprintf("%s failed to move: %s", failed, res.err.Error()))
+			g.fallbackAI(failed)
+			return
 		}
-		return bestScore
+		g.playAIMove(res.move)
+	default:
+	}
+}
+
+// fallbackAI computes the move of a strategy that failed with the fallback strategy, instead of
+// restarting the failing one on the next frame while the AI keeps the turn. The game ends when
+// even the fallback strategy fails.
+func (g *Game) fallbackAI(failed string) {
+	if failed != aiFallback {
+		job, err := g.newAIJob(aiFallback, aiDeadline)
+		if err == nil {
+			g.aiJob = job
+			return
+		}
+		log.LogMessage(log.ERROR, fmt.Sprintf("%s failed to start: %s", aiFallback, err.Error()))
+	}
+	log.LogMessage(log.ERROR, "the AI cannot move, the game ends")
+	g.cancelAI()
+	g.state = StateGameOver
+}
+
+// cancelAI stops the moves being computed by the AI and for a hint, their results are dropped.
+func (g *Game) cancelAI() {
+	if g.aiJob != nil {
+		g.aiJob.cancel()
+		g.aiJob = nil
+	}
+	g.cancelHint()
+}
+
+// aiThinking returns whether the AI is computing its move.
+func (g *Game) aiThinking() bool {
+	return g.aiJob != nil
+}
+
+// playAIMove plays the move of the AI, encoded as a move of the position of the current game.
+func (g *Game) playAIMove(move int) {
+	switch {
+	case g.ultimate != nil:
+		g.playUltimate(ai.UltimateCell(move))
+	case g.cube != nil:
+		g.playCube(ai.CubeCell(g.cube.Size, move))
+	default:
+		m := ai.ClassicCell(move)
+		g.wildSymbol = SymbolPlaying(m.Symbol)
+		g.performMove(m.X, m.Y)
 	}
 }
@@ -0,0 +1,179 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/ai"
+	"GoRythm/internal/rules"
+	"testing"
+	"time"
+)
+
+// waitAI updates the AI until it plays its move, or fails the test after a few seconds.
+func waitAI(t *testing.T, g *Game, strategy string, minThink time.Duration) {
+	t.Helper()
+	rounds := g.rounds
+	deadline := time.Now().Add(5 * time.Second)
+	for g.rounds == rounds {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected %s to play, got no move", strategy)
+		}
+		g.updateAI(strategy, minThink)
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// TestGame_updateAI_easy tests the updateAI function with the strategy of the easy AI.
+// Checks if the AI thinks without blocking, then plays on an empty cell.
+func TestGame_updateAI_easy(t *testing.T) {
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.gameMode = EASY_AI_MODE
+	g.currentPlayerSymbol = X_PLAYING
+	g.startPosition()
+
+	g.updateAI(g.aiStrategy(), time.Hour)
+	if !g.aiThinking() || g.rounds != 0 {
+		t.Fatalf("Expected the AI to think before the minimum time, got %d rounds", g.rounds)
+	}
+	g.aiJob.start = time.Now().Add(-time.Hour)
+	waitAI(t, g, g.aiStrategy(), time.Hour)
+	if g.aiThinking() || g.rounds != 1 {
+		t.Errorf("Expected the AI to play once, got %d rounds", g.rounds)
+	}
+}
+
+// TestGame_updateAI_hard tests the updateAI function with the strategy of the hard AI.
+// Checks if the AI wins playing X or O.
+func TestGame_updateAI_hard(t *testing.T) {
+	for _, player := range []SymbolPlaying{X_PLAYING, O_PLAYING} {
+		g := NewGame()
+		if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		g.gameMode = HARD_AI_MODE
+		opponent := SymbolPlaying(rules.Symbol(player).Opponent())
+		// The AI can complete the first column, its opponent the second one
+		g.board = [3][3]SymbolPlaying{
+			{player, player, NONE_PLAYING},
+			{opponent, opponent, NONE_PLAYING},
+			{NONE_PLAYING, NONE_PLAYING, NONE_PLAYING},
+		}
+		g.currentPlayerSymbol = player
+		waitAI(t, g, g.aiStrategy(), 0)
+		if g.board[0][2] != player || g.boardWinner() != player {
+			t.Errorf("Expected %v to win at (0, 2), got %v", player, g.board)
+		}
+	}
+}
+
+// TestGame_updateAI_wild tests the updateAI function with the Wild variant.
+// Checks if the hard AI plays the symbol completing a line.
+func TestGame_updateAI_wild(t *testing.T) {
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.gameMode = HARD_AI_MODE
+	g.settings.Rules = rules.Variant{Wild: true}
+	g.currentPlayerSymbol = O_PLAYING
+	g.startPosition()
+	for _, m := range []rules.Move{{X: 0, Y: 0, Symbol: rules.X}, {X: 2, Y: 2, Symbol: rules.O}, {X: 0, Y: 1, Symbol: rules.X}} {
+		g.position.Play(m)
+	}
+	g.currentPlayerSymbol = X_PLAYING
+	waitAI(t, g, g.aiStrategy(), 0)
+	if g.board[0][2] != X_PLAYING || g.boardWinner() != X_PLAYING {
+		t.Errorf("Expected X to win by placing X at (0, 2), got %v", g.board)
+	}
+}
+
+// TestGame_updateAI_failing tests the updateAI function with a strategy that fails.
+// Checks if the fallback strategy plays the move, and if the game ends when it fails too.
+func TestGame_updateAI_failing(t *testing.T) {
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.gameMode = HARD_AI_MODE
+	g.state = StatePlaying
+	g.currentPlayerSymbol = X_PLAYING
+	g.startPosition()
+
+	// A search failing as an engine that stopped answering
+	results := make(chan aiResult, 1)
+	results <- aiResult{err: ai.ErrNoMove}
+	g.aiJob = &aiJob{strategy: "minimax", cancel: func() {}, results: results}
+	waitAI(t, g, "minimax", 0)
+	if g.rounds != 1 || g.state != StatePlaying {
+		t.Fatalf("Expected the fallback strategy to play, got %d rounds", g.rounds)
+	}
+	// A strategy failing to start
+	waitAI(t, g, "nope", 0)
+	if g.rounds != 2 {
+		t.Fatalf("Expected the fallback strategy to play, got %d rounds", g.rounds)
+	}
+
+	g.fallbackAI(aiFallback)
+	if g.aiThinking() || g.state != StateGameOver {
+		t.Errorf("Expected the game to end, got the state %v", g.state)
+	}
+}
+
+// TestGame_cancelAI tests the cancelAI function.
+// Checks if the move being computed is dropped when the game is paused or restarted.
+func TestGame_cancelAI(t *testing.T) {
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.gameMode = HARD_AI_MODE
+	g.currentPlayerSymbol = X_PLAYING
+	g.startPosition()
+	g.updateAI(g.aiStrategy(), 0)
+	job := g.aiJob
+	g.pauseGame()
+	if g.aiThinking() {
+		t.Fatal("Expected the AI to stop thinking on pause")
+	}
+	select {
+	case <-job.results:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the search to stop once cancelled")
+	}
+	if g.rounds != 0 {
+		t.Errorf("Expected the cancelled move not to be played, got %d rounds", g.rounds)
+	}
+
+	g.resumeGame()
+	g.updateAI(g.aiStrategy(), 0)
+	g.restartGame()
+	if g.aiThinking() {
+		t.Error("Expected the AI to stop thinking on restart")
+	}
+}
+
+// TestGame_selectMedium tests the selectMedium function.
+// Checks if the medium AI is selected first, then if its level cycles and gives its strategy.
+func TestGame_selectMedium(t *testing.T) {
+	g := NewGame()
+	g.selectMedium()
+	if g.gameMode != MEDIUM_AI_MODE || g.aiStrategy() != ai.LevelName(defaultAILevel) {
+		t.Errorf("Expected the medium AI at level %d, got mode %v with %q", defaultAILevel, g.gameMode, g.aiStrategy())
+	}
+	g.selectMedium()
+	if g.aiLevel != defaultAILevel+1 {
+		t.Errorf("Expected level %d, got %d", defaultAILevel+1, g.aiLevel)
+	}
+	g.aiLevel = ai.MaxLevel
+	g.selectMedium()
+	if g.aiLevel != ai.MinLevel {
+		t.Errorf("Expected the level to cycle to %d, got %d", ai.MinLevel, g.aiLevel)
+	}
+	if !g.hasAI() || len(g.localPlayers()) != 1 || difficultyName(g.gameMode) != "medium" {
+		t.Error("Expected the medium AI to be played and recorded as an AI mode")
+	}
+}
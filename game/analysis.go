@@ -0,0 +1,74 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/solver"
+	t "GoRythm/internal/text"
+	"GoRythm/internal/theme"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// gameSolver returns the solver of the local 3x3 game, or nil when the game cannot be solved:
+// online, in the Ultimate and 3D modes, or with a symbol limit.
+func (g *Game) gameSolver() *solver.Solver {
+	if g.position == nil || g.online != nil {
+		return nil
+	}
+	if g.solver == nil || g.solver.Variant() != g.position.Variant {
+		s, err := solver.New(g.position.Variant)
+		if err != nil {
+			return nil
+		}
+		g.solver = s
+	}
+	return g.solver
+}
+
+// cellValues returns the value of the best move of each empty cell for the current player with
+// perfect play, or nil when the game is finished or cannot be solved.
+func (g *Game) cellValues() map[[2]int]solver.MoveValue {
+	s := g.gameSolver()
+	if s == nil || g.position.Over() {
+		return nil
+	}
+	moves, err := s.Moves(g.position.Board, g.position.Turn)
+	if err != nil {
+		return nil
+	}
+	values := map[[2]int]solver.MoveValue{}
+	// The moves are sorted, the first move of a cell is its best one
+	for _, m := range moves {
+		cell := [2]int{m.Move.X, m.Move.Y}
+		if _, ok := values[cell]; !ok {
+			values[cell] = m
+		}
+	}
+	return values
+}
+
+// DrawAnalysis draws the value of the best move of each empty cell for the current player:
+// W for a win, D for a draw and L for a loss, followed by the number of moves until the end.
+// In the Wild variant, the symbol of the best move is shown too.
+func (g *Game) DrawAnalysis(screen *ebiten.Image) {
+	wild := g.position != nil && g.position.Variant.Wild
+	for cell, m := range g.cellValues() {
+		msgValue := m.Value.Short()
+		if wild {
+			msgValue += " " + string(m.Move.Symbol)
+		}
+		var c color.Color
+		switch m.Value.Outcome {
+		case solver.Win:
+			c = theme.WinValueColor
+		case solver.Loss:
+			c = theme.LossValueColor
+		default:
+			c = theme.TextColor
+		}
+		t.DrawText(screen, msgValue, t.NormalText, cell[0]*g.sWidth/3+10, (cell[1]+1)*g.sWidth/3-30, c)
+	}
+}
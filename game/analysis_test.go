@@ -0,0 +1,38 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/rules"
+	"GoRythm/internal/solver"
+	"testing"
+)
+
+// TestGame_cellValues tests the cellValues function.
+// Checks if the winning cell is valued as a win, and if a symbol limit cannot be analysed.
+func TestGame_cellValues(t *testing.T) {
+	g := NewGame()
+	g.currentPlayerSymbol = X_PLAYING
+	g.startPosition()
+	// X can complete the first column, O the second one
+	for _, m := range []rules.Move{{X: 0, Y: 0, Symbol: rules.X}, {X: 1, Y: 0, Symbol: rules.O}, {X: 0, Y: 1, Symbol: rules.X}, {X: 1, Y: 1, Symbol: rules.O}} {
+		g.position.Play(m)
+	}
+	values := g.cellValues()
+	if len(values) != 5 {
+		t.Fatalf("Expected 5 empty cells, got %d", len(values))
+	}
+	if v := values[[2]int{0, 2}].Value; v != (solver.Value{Outcome: solver.Win, Distance: 1}) {
+		t.Errorf("Expected a win in 1 at (0, 2), got %v", v)
+	}
+	if v := values[[2]int{2, 2}].Value; v.Outcome != solver.Loss {
+		t.Errorf("Expected a loss at (2, 2), got %v", v)
+	}
+
+	g.settings.Rules = rules.Variant{MaxSymbols: 3}
+	g.startPosition()
+	if values := g.cellValues(); values != nil {
+		t.Errorf("Expected no analysis with a symbol limit, got %v", values)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/clock"
+	"GoRythm/internal/rules"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// timeControl returns the time control selected in the menu.
+func (g *Game) timeControl() clock.Control {
+	return clock.Presets[g.timeControlPreset]
+}
+
+// nextTimeControl selects the next time control of the menu.
+func (g *Game) nextTimeControl() {
+	g.timeControlPreset = (g.timeControlPreset + 1) % len(clock.Presets)
+}
+
+// hasClock returns whether the game mode is played with the selected time control.
+// The GoRythm and online modes are paced by the music instead.
+func (g *Game) hasClock() bool {
+	switch g.gameMode {
+	case CLASSIC_PVP_MODE, EASY_AI_MODE, MEDIUM_AI_MODE, HARD_AI_MODE, ULTIMATE_MODE, CUBE_MODE:
+		return g.timeControl().Enabled()
+	}
+	return false
+}
+
+// startClock starts the time of the current player when the game starts, if the game mode is timed.
+func (g *Game) startClock() {
+	g.clock = nil
+	if g.hasClock() {
+		g.clock = clock.New(g.timeControl())
+		g.clock.Start(rules.Symbol(g.currentPlayerSymbol), time.Now())
+	}
+}
+
+// checkTimeout ends the game when a player is out of time, its opponent wins.
+func (g *Game) checkTimeout() bool {
+	if g.clock == nil {
+		return false
+	}
+	flagged := g.clock.Flagged(time.Now())
+	if flagged == rules.None {
+		return false
+	}
+	g.timeout = SymbolPlaying(flagged)
+	g.win = SymbolPlaying(flagged.Opponent())
+	if g.win == O_PLAYING {
+		g.pointsO += scorePerWin
+	} else {
+		g.pointsX += scorePerWin
+	}
+	g.state = StateGameOver
+	return true
+}
+
+// stopClock stops the time of both players at the end of the game.
+func (g *Game) stopClock() {
+	if g.clock != nil {
+		g.clock.Stop(time.Now())
+	}
+}
+
+// pauseGame pauses a local game: the music, the beats and the clock are stopped until the game is resumed.
+// The move being computed by the AI is cancelled, the AI thinks again when the game is resumed.
+func (g *Game) pauseGame() {
+	g.pauseTime = time.Now()
+	g.cancelAI()
+	if g.clock != nil {
+		g.clock.Pause(g.pauseTime)
+	}
+	if g.audioPlayer != nil {
+		g.audioPlayer.Pause()
+	}
+	g.state = StatePause
+}
+
+// resumeGame resumes a paused game. The beats of the GoRythm mode are delayed by the
+// duration of the pause to stay in time with the music.
+func (g *Game) resumeGame() {
+	now := time.Now()
+	paused := now.Sub(g.pauseTime)
+	if g.goRythm != nil && !g.goRythm.startTime.IsZero() {
+		g.goRythm.startTime = g.goRythm.startTime.Add(paused)
+		g.goRythm.circleColorChangeTime = g.goRythm.circleColorChangeTime.Add(paused)
+	}
+	if g.clock != nil {
+		g.clock.Resume(now)
+	}
+	if g.audioPlayer != nil {
+		g.audioPlayer.Play()
+	}
+	g.state = StatePlaying
+}
+
+// handleStatePause handles the pause inputs, P or Escape resumes the game.
+func (g *Game) handleStatePause() {
+	if inpututil.IsKeyJustPressed(ebiten.KeyP) || inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.resumeGame()
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/clock"
+	"GoRythm/internal/rules"
+	"testing"
+	"time"
+)
+
+// TestGame_checkTimeout tests the checkTimeout function in a timed PvP game.
+// Checks if the player out of time loses and its opponent scores the win.
+func TestGame_checkTimeout(t *testing.T) {
+	g := NewGame()
+	g.gameMode = CLASSIC_PVP_MODE
+	g.state = StatePlaying
+	g.currentPlayerSymbol = X_PLAYING
+	g.clock = clock.New(clock.Control{Bank: time.Second})
+	g.clock.Start(rules.X, time.Now().Add(-2*time.Second))
+
+	if !g.checkTimeout() {
+		t.Fatal("Expected X to be out of time")
+	}
+	if g.state != StateGameOver || g.win != O_PLAYING || g.timeout != X_PLAYING || g.pointsO != scorePerWin {
+		t.Errorf("Expected O to win on time, got state %v, winner %s and %d points", g.state, g.win, g.pointsO)
+	}
+}
+
+// TestGame_performMove_clock tests the performMove function in a timed game.
+// Checks if the clock of the opponent runs after a move.
+func TestGame_performMove_clock(t *testing.T) {
+	g := NewGame()
+	g.gameMode = CLASSIC_PVP_MODE
+	g.timeControlPreset = 1
+	g.currentPlayerSymbol = X_PLAYING
+	g.startClock()
+	if g.clock == nil {
+		t.Fatal("Expected a clock in a timed PvP game")
+	}
+
+	g.performMove(1, 1)
+	if g.clock.Running() != rules.O {
+		t.Errorf("Expected the time of O to run, got %s", g.clock.Running())
+	}
+}
+
+// TestGame_startClock tests the startClock function in GoRythm mode.
+// Checks if the music paced modes have no clock.
+func TestGame_startClock(t *testing.T) {
+	g := NewGame()
+	g.gameMode = GORYTHM_MODE
+	g.timeControlPreset = 1
+	g.startClock()
+	if g.clock != nil {
+		t.Error("Expected no clock in GoRythm mode")
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/cube"
+	"GoRythm/internal/log"
+	"GoRythm/internal/rules"
+	t "GoRythm/internal/text"
+	"GoRythm/internal/theme"
+	"fmt"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const (
+	cubeLayerMargin = 20 // The margin around each layer of the cube, in pixels
+)
+
+// selectCube selects the 3D mode, or changes the size of the cube if it is already selected.
+func (g *Game) selectCube() {
+	if g.gameMode == CUBE_MODE {
+		g.cubeSize++
+		if g.cubeSize > cube.MaxSize {
+			g.cubeSize = cube.MinSize
+		}
+	}
+	g.gameMode = CUBE_MODE
+}
+
+// startCube starts a 3D game with the current player, the cursor is on the first layer.
+func (g *Game) startCube() {
+	game, err := cube.New(g.cubeSize, rules.Symbol(g.currentPlayerSymbol))
+	if err != nil {
+		log.LogMessage(log.ERROR, "failed to start the 3D game: "+err.Error())
+		g.cubeSize = cube.MinSize
+		game, _ = cube.New(g.cubeSize, rules.Symbol(g.currentPlayerSymbol))
+	}
+	g.cube = game
+	g.cubeCursor = cube.Move{}
+}
+
+// handleCubePlaying handles the inputs of a 3D game. The AI thinks of its move, a human moves the
+// cursor in the layer with the arrows, changes the layer with Page Up/Page Down or the brackets,
+// and plays with Enter or Space, or clicks on a cell of any layer.
+func (g *Game) handleCubePlaying() {
+	if g.currentPlayerType == AI_TYPE {
+		g.updateAI(g.aiStrategy(), aiMinThink)
+		return
+	}
+	move := func(c *int, direction int) {
+		*c = min(max(*c+direction, 0), g.cube.Size-1)
+	}
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyLeft):
+		move(&g.cubeCursor.X, -1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyRight):
+		move(&g.cubeCursor.X, 1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyUp):
+		move(&g.cubeCursor.Y, -1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyDown):
+		move(&g.cubeCursor.Y, 1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyPageUp), inpututil.IsKeyJustPressed(ebiten.KeyBracketLeft):
+		move(&g.cubeCursor.Z, -1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyPageDown), inpututil.IsKeyJustPressed(ebiten.KeyBracketRight):
+		move(&g.cubeCursor.Z, 1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnter), inpututil.IsKeyJustPressed(ebiten.KeySpace):
+		g.playCube(g.cubeCursor)
+	case inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft):
+		if m, ok := g.cubeCellAt(ebiten.CursorPosition()); ok {
+			g.cubeCursor = m
+			g.playCube(m)
+		}
+	}
+}
+
+// playCube plays the move for the current player if it is legal and switches the player and
+// its clock. It ends the game when a line is aligned or when the cube is full.
+func (g *Game) playCube(m cube.Move) {
+	if err := g.cube.Play(m); err != nil {
+		log.LogMessage(log.DEBUG, "3D move refused: "+err.Error())
+		return
+	}
+	if g.clock != nil {
+		g.clock.Switch(time.Now())
+	}
+	g.switchPlayer()
+	g.rounds++
+
+	winner, _ := g.cube.Winner()
+	g.win = SymbolPlaying(winner)
+	switch g.win {
+	case X_PLAYING:
+		g.pointsX += scorePerWin
+	case O_PLAYING:
+		g.pointsO += scorePerWin
+	}
+	if g.cube.Over() {
+		g.state = StateGameOver
+	}
+}
+
+// cubeLayout returns the position of the top-left corner of the layer and the size of its cells.
+// The layers are stacked two by two from the top-left corner of the board.
+func (g *Game) cubeLayout(z int) (x, y, cell float32) {
+	area := float32(g.sWidth) / 2
+	cell = (area - 2*cubeLayerMargin) / float32(g.cube.Size)
+	return float32(z%2)*area + cubeLayerMargin, float32(z/2)*area + cubeLayerMargin, cell
+}
+
+// cubeCellAt returns the cell at the position of the screen, if any.
+func (g *Game) cubeCellAt(x, y int) (cube.Move, bool) {
+	for z := 0; z < g.cube.Size; z++ {
+		ox, oy, cell := g.cubeLayout(z)
+		cx, cy := int((float32(x)-ox)/cell), int((float32(y)-oy)/cell)
+		if float32(x) >= ox && float32(y) >= oy && cx < g.cube.Size && cy < g.cube.Size {
+			return cube.Move{X: cx, Y: cy, Z: z}, true
+		}
+	}
+	return cube.Move{}, false
+}
+
+// DrawCube draws the layers of the cube with their symbols, the cursor and the winning cells.
+func (g *Game) DrawCube(screen *ebiten.Image) {
+	_, line := g.cube.Winner()
+	winning := make(map[cube.Move]bool, len(line))
+	for _, m := range line {
+		winning[m] = true
+	}
+
+	for z := 0; z < g.cube.Size; z++ {
+		ox, oy, cell := g.cubeLayout(z)
+		side := cell * float32(g.cube.Size)
+		t.DrawText(screen, fmt.Sprintf("Layer %d", z+1), t.NormalText, int(ox), int(oy)-cubeLayerMargin, theme.TextColor)
+		if z == g.cubeCursor.Z && g.state == StatePlaying {
+			vector.DrawFilledRect(screen, ox, oy, side, side, theme.ActiveBoardColor, false)
+		}
+		for i := 0; i <= g.cube.Size; i++ {
+			position := float32(i) * cell
+			vector.StrokeLine(screen, ox+position, oy, ox+position, oy+side, ultimateThinLine, theme.BoardColor, false)
+			vector.StrokeLine(screen, ox, oy+position, ox+side, oy+position, ultimateThinLine, theme.BoardColor, false)
+		}
+		for x := 0; x < g.cube.Size; x++ {
+			for y := 0; y < g.cube.Size; y++ {
+				m := cube.Move{X: x, Y: y, Z: z}
+				cx, cy := ox+float32(x)*cell, oy+float32(y)*cell
+				if winning[m] {
+					vector.StrokeRect(screen, cx, cy, cell, cell, 4, theme.WinningLineColor, false)
+				}
+				drawSymbol(screen, g.cube.Cell(m), cx, cy, cell, 3, symbolColor(g.cube.Cell(m)))
+			}
+		}
+	}
+
+	if g.state == StatePlaying && g.currentPlayerType == HUMAN_TYPE {
+		ox, oy, cell := g.cubeLayout(g.cubeCursor.Z)
+		vector.StrokeRect(screen, ox+float32(g.cubeCursor.X)*cell, oy+float32(g.cubeCursor.Y)*cell, cell, cell, 3, theme.SelectedTextColor, false)
+	}
+}
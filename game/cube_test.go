@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/cube"
+	"GoRythm/internal/rules"
+	"testing"
+	"time"
+)
+
+// TestGame_selectCube tests the selectCube function.
+// Checks if the 3D mode is selected first, then if the size of the cube changes.
+func TestGame_selectCube(t *testing.T) {
+	g := NewGame()
+	g.selectCube()
+	if g.gameMode != CUBE_MODE || g.cubeSize != 3 {
+		t.Errorf("Expected the 3x3x3 mode, got mode %v with size %d", g.gameMode, g.cubeSize)
+	}
+	g.selectCube()
+	if g.cubeSize != 4 {
+		t.Errorf("Expected size 4, got %d", g.cubeSize)
+	}
+	g.selectCube()
+	if g.cubeSize != 3 {
+		t.Errorf("Expected size 3, got %d", g.cubeSize)
+	}
+}
+
+// TestGame_playCube tests the playCube function against the AI.
+// Checks if the AI answers a move and if a line through the layers ends the game.
+func TestGame_playCube(t *testing.T) {
+	g := NewGame()
+	g.gameMode = CUBE_MODE
+	g.aiOpponent = true
+	g.state = StatePlaying
+	g.currentPlayerSymbol = X_PLAYING
+	g.currentPlayerType = HUMAN_TYPE
+	g.startCube()
+
+	g.playCube(cube.Move{X: 0, Y: 0, Z: 0})
+	if g.currentPlayerType != AI_TYPE || g.cube.Cell(cube.Move{}) != rules.X {
+		t.Fatalf("Expected the AI to play after X, got %s", g.currentPlayerType)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for g.cube.Rounds == 1 && time.Now().Before(deadline) {
+		g.handleCubePlaying()
+		time.Sleep(time.Millisecond)
+	}
+	if g.cube.Rounds != 2 || g.currentPlayerType != HUMAN_TYPE {
+		t.Errorf("Expected the AI to play, got %d rounds", g.cube.Rounds)
+	}
+
+	g.cube.Cells = make([]rules.Symbol, 27)
+	g.cube.Cells[0], g.cube.Cells[13] = rules.X, rules.X
+	g.cube.Turn = rules.X
+	g.playCube(cube.Move{X: 2, Y: 2, Z: 2})
+	if g.state != StateGameOver || g.win != X_PLAYING {
+		t.Errorf("Expected X to win, got state %v and winner %q", g.state, g.win)
+	}
+}
@@ -7,8 +7,19 @@ package game
 
 import (
 	a "GoRythm/internal/audio"
+	"GoRythm/internal/clock"
+	"GoRythm/internal/cube"
+	"GoRythm/internal/discovery"
 	gen "GoRythm/internal/generation"
 	"GoRythm/internal/log"
+	"GoRythm/internal/network"
+	"GoRythm/internal/profiles"
+	"GoRythm/internal/rules"
+	"GoRythm/internal/scores"
+	"GoRythm/internal/series"
+	"GoRythm/internal/settings"
+	"GoRythm/internal/solver"
+	"GoRythm/internal/ultimate"
 	"fmt"
 	"time"
 
@@ -31,9 +42,78 @@ type Game struct {
 	pointsX             int                 // The point number for player X
 	rounds              int                 // The number of rounds
 	win                 SymbolPlaying       // The winning player ("O" or "X")
+	humanSymbol         SymbolPlaying       // The symbol of the human player against the AI
+	aiLevel             int                 // The skill level of the medium AI
+	aiJob               *aiJob              // The move being computed by the AI, nil when it is not thinking
 
 	goRythm *GoRythm // GoRythm mode game struct
 
+	position      *rules.Game         // The rules of the local 3x3 games with their variant
+	wildSymbol    SymbolPlaying       // The symbol placed by the next move in the Wild variant
+	rulesSelected rulesItem           // The selected item of the rules screen
+	analysis      bool                // Whether the value of each empty cell is shown
+	review        []solver.Assessment // The moves of the reviewed game compared with the best moves
+	reviewMove    int                 // The index of the move selected on the review screen
+	hint          rules.Move          // The best move shown by the last hint
+	hintTime      time.Time           // The time of the last hint
+	hintRound     int                 // The round of the last hint, it is hidden once a move is played
+	hintJob       *aiJob              // The search of the hint when the game cannot be solved, nil when none is running
+	solver        *solver.Solver      // The solver of the variant of the 3x3 game, created when first analysed
+
+	ultimate       *ultimate.Game // Ultimate mode game struct
+	ultimateCursor [2]int         // The cell selected with the keyboard on the whole Ultimate board
+	aiOpponent     bool           // Whether the Ultimate and 3D modes are played against the AI
+
+	cube       *cube.Game // 3D mode game struct
+	cubeSize   int        // The size of the cube selected in the menu
+	cubeCursor cube.Move  // The cell selected with the keyboard in the 3D mode
+
+	watchPreset int // The index of the pair of strategies watched, in the order of watchPairs
+
+	series       *series.Series // The series of local games being played, nil for a single game
+	seriesPreset int            // The index of the series format selected in the menu
+
+	clock             *clock.Clock  // The chess clock of a timed game, nil without time control
+	timeControlPreset int           // The index of the time control selected in the menu
+	timeout           SymbolPlaying // The player who lost on time
+	pauseTime         time.Time     // The time the game was paused
+
+	online        *network.Client                    // The connection to the server in Online mode
+	serverAddress string                             // The address of the server for the Online mode
+	onlineStart   time.Time                          // The local time the music starts in Online mode
+	serverStart   time.Time                          // The server time the music starts in Online mode
+	onlineSongEnd bool                               // Whether the server was told the music ended
+	onlineError   string                             // The last connection error in Online mode
+	judgement     string                             // The judgement of the last move in an online GoRythm match
+	spectators    int                                // The number of spectators of the online match
+	onlineQueues  map[SymbolPlaying]*rules.MoveQueue // The symbols of each player in the order they will be removed online
+
+	browser          *discovery.Browser   // The browser of the games announced on the local network
+	discoveryAddress string               // The UDP address the games are announced on
+	lobbyGames       []discovery.Game     // The games listed in the lobby
+	lobbySelected    int                  // The index of the selected game in the lobby
+	lobbyTyping      bool                 // Whether a server address is typed in the lobby
+	lobbyAddress     string               // The server address typed in the lobby
+	host             *network.Server      // The server of the game hosted from the lobby
+	announcer        *discovery.Announcer // The announcer of the game hosted from the lobby
+
+	scores           *scores.Table // The local high scores
+	tallyX, tallyO   scores.Tally  // The judgements of the hits of each player
+	gameRecorded     bool          // Whether the results of the game were recorded
+	highScoreMode    GameMode      // The game mode shown on the high-score screen
+	highScoreRank    int           // The rank of the last recorded result, -1 if not ranked
+	highScoreMessage string        // The personal best message of the last recorded game
+
+	profiles        *profiles.Store // The player profiles
+	profileSelected int             // The index of the selected profile on the profiles screen
+	profileTyping   bool            // Whether the name of a new profile is typed
+	profileInput    string          // The name of the new profile
+	profileMessage  string          // The result of the last action on the profiles screen
+
+	settings         settings.Settings // The user settings
+	settingsSelected settingsItem      // The selected item of the settings screen
+	settingsError    string            // The last error when saving the settings
+
 	audioContext *audio.Context // The audio context for the game
 	audioPlayer  *a.AudioPlayer // The audio player for the game used to play the music
 
@@ -48,8 +128,7 @@ type Game struct {
 }
 
 const (
-	countdownDuration = 3 // The countdown before starting the game (in seconds)
-	scorePerWin       = 1 // The score per win in Classic mode
+	scorePerWin = 1 // The score per win in Classic mode
 )
 
 // Global variables
@@ -92,10 +171,16 @@ func NewGame() *Game {
 		rounds:              0,
 		win:                 NONE_PLAYING,
 		goRythm:             nil,
+		online:              nil,
+		serverAddress:       fmt.Sprintf("localhost:%d", network.DefaultPort),
+		discoveryAddress:    discovery.DefaultGroup,
+		settings:            settings.Default(),
 		audioContext:        nil,
 		audioPlayer:         nil,
 		countdownTime:       time.Time{},
-		countdown:           countdownDuration,
+		countdown:           settings.DefaultCountdown,
+		cubeSize:            cube.MinSize,
+		aiLevel:             defaultAILevel,
 	}
 }
 
@@ -104,6 +189,16 @@ func (g *Game) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeigh
 	return g.sWidth, g.sHeight
 }
 
+// SetServerAddress sets the address of the server used by the Online mode.
+func (g *Game) SetServerAddress(addr string) {
+	g.serverAddress = addr
+}
+
+// SetDiscoveryAddress sets the UDP address the games are announced on in the lobby.
+func (g *Game) SetDiscoveryAddress(addr string) {
+	g.discoveryAddress = addr
+}
+
 // Init initialize the game attributes, must be called before running the game.
 func (g *Game) Init(audioContext *audio.Context, sWidth, sHeight int) error {
 	// Set variables
@@ -133,6 +228,30 @@ func (g *Game) Update() error {
 	case StateMenu:
 		g.handleStateMenu()
 
+	case StateLobby:
+		g.handleStateLobby()
+
+	case StateSettings:
+		g.handleStateSettings()
+
+	case StateHighScores:
+		g.handleStateHighScores()
+
+	case StateProfiles:
+		g.handleStateProfiles()
+
+	case StateSeriesSummary:
+		g.handleStateSeriesSummary()
+
+	case StateRules:
+		g.handleStateRules()
+
+	case StateReview:
+		g.handleStateReview()
+
+	case StatePause:
+		g.handleStatePause()
+
 	case StateLoading:
 		err := g.handleStateLoading()
 		if err != nil {
@@ -156,14 +275,26 @@ func (g *Game) Update() error {
 }
 
 // handleStateMenu handles the menu state inputs and changes to the loading state
-// when Enter is pressed.
+// when Enter is pressed, or to the lobby in Online mode.
 func (g *Game) handleStateMenu() {
 	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		if g.gameMode == ONLINE_MODE {
+			g.openLobby()
+			return
+		}
+		if g.isOnlineMode() {
+			if err := g.connectOnline(); err != nil {
+				log.LogMessage(log.ERROR, err.Error())
+				g.onlineError = err.Error()
+				return
+			}
+		}
 		g.state = StateLoading
 		g.countdownTime = time.Now()
-		if g.gameMode == GORYTHM_MODE {
-			g.goRythm = NewGoRythm()
-		}
+		g.humanSymbol = g.currentPlayerSymbol
+		g.currentPlayerType = HUMAN_TYPE
+		g.startSeries()
+		g.startModeBoard()
 	}
 	if inpututil.IsKeyJustPressed(ebiten.Key1) {
 		g.gameMode = CLASSIC_PVP_MODE
@@ -177,12 +308,54 @@ func (g *Game) handleStateMenu() {
 	if inpututil.IsKeyJustPressed(ebiten.Key4) {
 		g.gameMode = GORYTHM_MODE
 	}
+	if inpututil.IsKeyJustPressed(ebiten.Key5) {
+		g.gameMode = ONLINE_MODE
+	}
+	if inpututil.IsKeyJustPressed(ebiten.Key6) {
+		g.gameMode = SPECTATOR_MODE
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyU) {
+		g.gameMode = ULTIMATE_MODE
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyC) {
+		g.selectCube()
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyM) {
+		g.selectMedium()
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyW) {
+		g.selectWatch()
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyA) {
+		g.aiOpponent = !g.aiOpponent
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyR) {
+		g.openRules()
+	}
+	if inpututil.IsKeyJustPressed(ebiten.Key7) {
+		g.openSettings()
+	}
+	if inpututil.IsKeyJustPressed(ebiten.Key8) {
+		g.openHighScores(g.gameMode)
+	}
+	if inpututil.IsKeyJustPressed(ebiten.Key9) {
+		g.openProfiles()
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyTab) {
+		g.nextSeriesPreset()
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyT) {
+		g.nextTimeControl()
+	}
 }
 
 // handleStateLoading handles the loading state and changes to the playing state
 // when the countdown reaches 0.
 func (g *Game) handleStateLoading() error {
-	g.currentPlayerType = HUMAN_TYPE
+	if g.isOnlineMode() {
+		g.currentPlayerType = HUMAN_TYPE
+		return g.handleOnlineLoading()
+	}
 	if g.countdown > 0 {
 		elapsed := time.Since(g.countdownTime)
 		if elapsed >= time.Second {
@@ -191,6 +364,7 @@ func (g *Game) handleStateLoading() error {
 		}
 	} else {
 		g.state = StatePlaying
+		g.startClock()
 		if g.audioPlayer != nil {
 			g.audioPlayer.Play()
 		} else {
@@ -202,59 +376,70 @@ func (g *Game) handleStateLoading() error {
 
 // handleStatePlaying handles the playing state. The game logic is different depending
 // on the game mode.
-// It also handles the inputs for the players and the AI as well as the win and draw conditions
-// to change to the game over state.
+// It also handles the inputs for the players and the AI as well as the win, draw and time
+// conditions to change to the game over state. P or Escape pauses the game.
 func (g *Game) handleStatePlaying() error {
+	if g.isOnlineMode() {
+		return g.handleOnlinePlaying()
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyP) || inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.pauseGame()
+		return nil
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyV) {
+		g.analysis = !g.analysis
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyH) {
+		g.askHint()
+	}
+	g.updateHint()
+	if g.checkTimeout() {
+		return nil
+	}
+	if g.gameMode == ULTIMATE_MODE || g.gameMode == CUBE_MODE {
+		if !g.audioPlayer.IsPlaying() {
+			g.state = StateGameOver
+			return nil
+		}
+		if g.gameMode == ULTIMATE_MODE {
+			g.handleUltimatePlaying()
+		} else {
+			g.handleCubePlaying()
+		}
+		return nil
+	}
 	if g.gameMode == GORYTHM_MODE && g.goRythm.startTime.IsZero() {
 		g.goRythm.Start(time.Now())
 		log.LogMessage(log.DEBUG, fmt.Sprintf("Start time: %v", g.goRythm.startTime))
 	}
 	// Stop the game when the music ends
 	if !g.audioPlayer.IsPlaying() {
-		g.checkWinScore()
+		g.win = g.checkWinScore()
 		g.state = StateGameOver
 	}
 	switch {
-	// Human vs easy AI
-	case g.currentPlayerType == AI_TYPE && g.gameMode == EASY_AI_MODE:
-		x, y := g.EasyCpu()
-		g.performMove(x, y)
-	// Human vs hard AI
-	case g.currentPlayerType == AI_TYPE && g.gameMode == HARD_AI_MODE:
-		x, y := g.HardCpu()
-		g.performMove(x, y)
+	// AI vs AI
+	case g.gameMode == WATCH_MODE:
+		g.watchMove()
+	// Human vs AI, the AI plays X or O
+	case g.currentPlayerType == AI_TYPE:
+		g.updateAI(g.aiStrategy(), aiMinThink)
 	// Human vs human
 	case g.currentPlayerType == HUMAN_TYPE:
+		if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+			g.switchWildSymbol()
+		}
 		for key, pos := range keyboardToBoard {
 			if inpututil.IsKeyJustPressed(key) {
 				x, y := pos[0], pos[1]
 				if g.board[x][y] == NONE_PLAYING {
-					// GoRythm mode
-					if g.gameMode == GORYTHM_MODE {
-						// Remove and highlight symbols if needed
-						remove, highlight, toRemove, toHighlight := g.goRythm.Update(g.currentPlayerSymbol, x, y)
-						if remove {
-							g.removeSymbol(toRemove[0], toRemove[1])
-						}
-						if highlight {
-							g.highlightSymbol(toHighlight[0], toHighlight[1])
-						}
-						// Calculating score on hitting the beat
-						score := g.goRythm.CalculateScore()
-						switch g.currentPlayerSymbol {
-						case O_PLAYING:
-							g.pointsO += score
-						case X_PLAYING:
-							g.pointsX += score
-						}
-					}
-					g.performMove(x, y)
+					g.playHumanMove(x, y)
 				}
 			}
 		}
 	}
 	// Check for win
-	g.win, _ = g.checkWinBoard()
+	g.win = g.boardWinner()
 	if g.win != NONE_PLAYING {
 		if g.win == O_PLAYING {
 			if g.gameMode == GORYTHM_MODE {
@@ -282,15 +467,36 @@ func (g *Game) handleStatePlaying() error {
 	return nil
 }
 
-// handleStateGameOver handles the game over state and restarts the game when Enter is pressed.
+// handleStateGameOver handles the game over state, records the results in the high scores
+// and the profiles, and restarts the game when Enter is pressed, showing the high scores of the game mode.
+// In a series, Enter starts the next game, and shows the series summary after the last one.
 func (g *Game) handleStateGameOver() error {
+	g.stopClock()
+	g.cancelAI()
+	g.recordGame()
+	if inpututil.IsKeyJustPressed(ebiten.KeyR) && g.startReview() {
+		return nil
+	}
 	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		if g.series != nil && !g.series.Over() {
+			return g.nextSeriesGame()
+		}
+		mode := g.gameMode
+		recorded := difficultyName(mode) != "" && len(g.localPlayers()) > 0
+
 		// Restart the game
 		g.gameImage.Clear()
+		g.disconnectOnline()
 		g.restartGame()
 
-		// Return to menu and stop the music
+		// Show the high scores or return to menu and stop the music
 		g.state = StateMenu
+		if recorded {
+			g.openHighScores(mode)
+		}
+		if g.series != nil {
+			g.state = StateSeriesSummary
+		}
 		if err := g.audioPlayer.Restart(); err != nil {
 			return err
 		}
@@ -301,22 +507,98 @@ func (g *Game) handleStateGameOver() error {
 // restartGame resets the game variables and randomizes the starting player.
 // Does not change the global state.
 func (g *Game) restartGame() {
-	g.board = [3][3]SymbolPlaying{} // Reset the game board
-	g.rounds = 0                    // Reset the number of rounds
-	g.win = NONE_PLAYING            // Reset the win status
-	g.gameMode = NO_MODE            // Reset the game mode
-	g.countdown = 3                 // Reset the countdown timer
-	g.pointsO = 0                   // Reset the points for O
-	g.pointsX = 0                   // Reset the points for X
+	g.board = [3][3]SymbolPlaying{}    // Reset the game board
+	g.rounds = 0                       // Reset the number of rounds
+	g.win = NONE_PLAYING               // Reset the win status
+	g.gameMode = NO_MODE               // Reset the game mode
+	g.countdown = g.settings.Countdown // Reset the countdown timer
+	g.pointsO = 0                      // Reset the points for O
+	g.pointsX = 0                      // Reset the points for X
+	g.onlineError = ""                 // Reset the online error message
+	g.tallyX = scores.Tally{}          // Reset the judgements of X
+	g.tallyO = scores.Tally{}          // Reset the judgements of O
+	g.gameRecorded = false             // Allow recording the next game
+	g.clock = nil                      // Reset the clock
+	g.timeout = NONE_PLAYING           // Reset the player who lost on time
+	g.ultimate = nil                   // Reset the Ultimate board
+	g.cube = nil                       // Reset the 3D board
+	g.position = nil                   // Reset the rules of the 3x3 game
+	g.hintTime = time.Time{}           // Hide the last hint
+	g.cancelAI()                       // Stop the move being computed by the AI
 
 	g.randomizeStartingPlayer() // Randomize the starting player
 }
 
-// performMove places the symbol, switching the player and incrementing the rounds.
-func (g *Game) performMove(x, y int) {
+// startModeBoard creates the board of the game modes with their own rules.
+func (g *Game) startModeBoard() {
+	switch g.gameMode {
+	case CLASSIC_PVP_MODE, EASY_AI_MODE, MEDIUM_AI_MODE, HARD_AI_MODE:
+		g.startPosition()
+	case GORYTHM_MODE:
+		g.goRythm = NewGoRythm()
+		g.startPosition()
+	case ULTIMATE_MODE:
+		g.startUltimate()
+	case CUBE_MODE:
+		g.startCube()
+	case WATCH_MODE:
+		g.startWatch()
+	}
+}
+
+// playHumanMove plays the move of the human player to move. The hit is scored on the beat in
+// GoRythm mode, and the analysis shown counted as a hint, only if the move is played.
+func (g *Game) playHumanMove(x, y int) {
+	player := g.currentPlayerSymbol
+	// The values of the analysis help as much as a hint
+	assisted := g.analysis && g.cellValues() != nil
+	if !g.performMove(x, y) {
+		return
+	}
+	if assisted {
+		g.tallyOf(player).Hints++
+	}
+	if g.gameMode == GORYTHM_MODE {
+		// Calculating score on hitting the beat
+		score, offset := g.goRythm.Hit()
+		g.tallyOf(player).AddTimed(score, offset)
+		switch player {
+		case O_PLAYING:
+			g.pointsO += score
+		case X_PLAYING:
+			g.pointsX += score
+		}
+	}
+}
+
+// performMove places the symbol, switching the player and its clock and incrementing the rounds.
+// With the rules of a variant, the move is refused if illegal, and the oldest symbol of the player
+// is removed when it reaches the symbol limit, the next one to be removed is highlighted.
+// It returns false if the move was refused.
+func (g *Game) performMove(x, y int) bool {
+	player := g.currentPlayerSymbol
+	if g.position != nil {
+		removed, err := g.position.Play(rules.Move{X: x, Y: y, Symbol: rules.Symbol(g.pieceSymbol())})
+		if err != nil {
+			log.LogMessage(log.DEBUG, "move refused: "+err.Error())
+			return false
+		}
+		for _, cell := range removed {
+			g.removeSymbol(cell[0], cell[1])
+		}
+	}
 	g.placeSymbol(x, y)
+	if g.position != nil {
+		if next, ok := g.position.NextRemoval(rules.Symbol(player)); ok {
+			g.highlightSymbol(next[0], next[1])
+		}
+	}
+	if g.clock != nil {
+		g.clock.Switch(time.Now())
+	}
 	g.switchPlayer()
 	g.rounds++
+	return true
 }
 
 // randomizeStartingPlayer randomizes the starting player.
@@ -338,6 +620,7 @@ func (g *Game) initAudio(ctx *audio.Context) error {
 		return err
 	} else {
 		g.audioPlayer = ap
+		g.audioPlayer.SetVolume(g.settings.Volume)
 	}
 	return nil
 }
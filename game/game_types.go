@@ -12,6 +12,13 @@ const (
 	StatePlaying
 	StatePause
 	StateGameOver
+	StateLobby
+	StateSettings
+	StateHighScores
+	StateProfiles
+	StateSeriesSummary
+	StateRules
+	StateReview
 )
 
 // A GamePlayer type represent the different type of players of a Game.
@@ -41,4 +48,10 @@ const (
 	HARD_AI_MODE
 	CLASSIC_PVP_MODE
 	GORYTHM_MODE
+	ONLINE_MODE
+	SPECTATOR_MODE
+	ULTIMATE_MODE
+	CUBE_MODE
+	WATCH_MODE
+	MEDIUM_AI_MODE
 )
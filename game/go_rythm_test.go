@@ -4,7 +4,7 @@
 package game
 
 import (
-	"GoRythm/internal/audio"
+	"GoRythm/internal/rhythm"
 	"testing"
 	"time"
 )
@@ -16,11 +16,8 @@ func TestNewGoRythm(t *testing.T) {
 	if gr == nil {
 		t.Fatal("Expected GoRythm instance, got nil")
 	}
-	if len(gr.movesO) != 0 || len(gr.movesX) != 0 {
-		t.Fatal("Expected empty move channels")
-	}
-	if gr.toBeRemovedO != noMove || gr.toBeRemovedX != noMove {
-		t.Fatal("Expected noMove for toBeRemovedO and toBeRemovedX")
+	if len(gr.beatMap) == 0 {
+		t.Fatal("Expected a beatmap")
 	}
 	if !gr.startTime.IsZero() || !gr.circleColorChangeTime.IsZero() {
 		t.Fatal("Expected zero startTime and circleColorChangeTime")
@@ -38,43 +35,6 @@ func TestStart(t *testing.T) {
 	}
 }
 
-// TestUpdate tests the Update function.
-// Checks if the function returns the expected values for remove, highlight, toRemove, and toHighlight.
-func TestUpdate(t *testing.T) {
-	gr := NewGoRythm()
-	gr.Start(time.Now())
-
-	// First move
-	remove, highlight, toBeRemoved, toBeHighlighted := gr.Update(X_PLAYING, 1, 1)
-	if remove || highlight && toBeRemoved != noMove && toBeHighlighted != noMove {
-		t.Fatal("Expected no remove or highlight on first move")
-	}
-
-	// Second move
-	remove, highlight, toBeRemoved, toBeHighlighted = gr.Update(X_PLAYING, 2, 2)
-	if remove || highlight && toBeRemoved != noMove && toBeHighlighted != noMove {
-		t.Fatal("Expected no remove or highlight on second move")
-	}
-
-	// Third move
-	remove, highlight, toBeRemoved, toBeHighlighted = gr.Update(X_PLAYING, 3, 3)
-	if remove || !highlight && toBeRemoved != noMove && toBeHighlighted == noMove {
-		t.Fatal("Expected not remove and highlight on third move")
-	}
-	if toBeHighlighted != [2]int{1, 1} {
-		t.Fatalf("Expected toBeHighlighted [1, 1], got %v", toBeHighlighted)
-	}
-
-	// Fourth move
-	remove, highlight, toRemove, toHighlight := gr.Update(X_PLAYING, 1, 2)
-	if !remove || !highlight {
-		t.Fatal("Expected remove and highlight on fourth move")
-	}
-	if toRemove != [2]int{1, 1} || toHighlight != [2]int{2, 2} {
-		t.Fatalf("Expected toRemove [1, 1] and toHighlight [2, 2], got %v and %v", toRemove, toHighlight)
-	}
-}
-
 // TestCalculateScore tests the CalculateScore function.
 // Checks if the score is perfect, good, ok, or missed based on the time the player makes a move.
 func TestCalculateScore(t *testing.T) {
@@ -84,7 +44,7 @@ func TestCalculateScore(t *testing.T) {
 	const beatInterval float64 = 1.0
 
 	// Mock beat map
-	gr.beatMap = []audio.Beat{
+	gr.beatMap = []rhythm.Beat{
 		{Time: beatInterval, BeatNum: 1},
 		{Time: beatInterval + 1, BeatNum: 2},
 		{Time: beatInterval + 2, BeatNum: 3},
@@ -94,24 +54,24 @@ func TestCalculateScore(t *testing.T) {
 	timeToSleep := beatInterval
 	time.Sleep(time.Duration(timeToSleep) * time.Second)
 	score := gr.CalculateScore()
-	if score != perfectScore {
-		t.Fatalf("Expected score %d, got %d", perfectScore, score)
+	if score != rhythm.PerfectScore {
+		t.Fatalf("Expected score %d, got %d", rhythm.PerfectScore, score)
 	}
 
 	// Good score
-	timeToSleep = beatInterval + perfectPrec - goodPrec
+	timeToSleep = beatInterval + rhythm.PerfectPrec - rhythm.GoodPrec
 	time.Sleep(time.Duration(timeToSleep) * time.Second)
 	score = gr.CalculateScore()
-	if score != perfectScore {
-		t.Fatalf("Expected score %d, got %d", goodScore, score)
+	if score != rhythm.PerfectScore {
+		t.Fatalf("Expected score %d, got %d", rhythm.GoodScore, score)
 	}
 
 	// Ok score
-	timeToSleep = beatInterval + goodPrec - okPrec
+	timeToSleep = beatInterval + rhythm.GoodPrec - rhythm.OkPrec
 	time.Sleep(time.Duration(timeToSleep) * time.Second)
 	score = gr.CalculateScore()
-	if score != perfectScore {
-		t.Fatalf("Expected score %d, got %d", okScore, score)
+	if score != rhythm.PerfectScore {
+		t.Fatalf("Expected score %d, got %d", rhythm.OkScore, score)
 	}
 }
 
@@ -124,7 +84,7 @@ func TestCalculateScoreMissed(t *testing.T) {
 	const beatInterval float64 = 1.0
 
 	// Mock beat map
-	gr.beatMap = []audio.Beat{
+	gr.beatMap = []rhythm.Beat{
 		{Time: beatInterval, BeatNum: 1},
 		{Time: beatInterval + 1, BeatNum: 2},
 		{Time: beatInterval + 2, BeatNum: 3},
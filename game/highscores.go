@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/log"
+	"GoRythm/internal/rhythm"
+	"GoRythm/internal/rules"
+	"GoRythm/internal/scores"
+	"fmt"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// The game modes with a high-score table, in the order of the high-score screen
+var highScoreModes = []GameMode{CLASSIC_PVP_MODE, EASY_AI_MODE, MEDIUM_AI_MODE, HARD_AI_MODE, GORYTHM_MODE, ONLINE_MODE}
+
+// difficultyName returns the name of the high-score table of a game mode,
+// or an empty string if the results of the mode are not recorded.
+func difficultyName(mode GameMode) string {
+	switch mode {
+	case CLASSIC_PVP_MODE:
+		return "pvp"
+	case EASY_AI_MODE:
+		return "easy"
+	case MEDIUM_AI_MODE:
+		return "medium"
+	case HARD_AI_MODE:
+		return "hard"
+	case GORYTHM_MODE:
+		return "gorythm"
+	case ONLINE_MODE:
+		return "online"
+	}
+	return ""
+}
+
+// loadScores loads the high-score table, an empty table is used on error.
+func (g *Game) loadScores() {
+	table, err := scores.Load()
+	if err != nil {
+		log.LogMessage(log.ERROR, "failed to load the scores: "+err.Error())
+	}
+	g.scores = table
+}
+
+// tallyOf returns the tally of the judgements of the given player.
+func (g *Game) tallyOf(symbol SymbolPlaying) *scores.Tally {
+	if symbol == O_PLAYING {
+		return &g.tallyO
+	}
+	return &g.tallyX
+}
+
+// localPlayers returns the symbols played on this machine by a human.
+func (g *Game) localPlayers() []SymbolPlaying {
+	switch g.gameMode {
+	case CLASSIC_PVP_MODE, GORYTHM_MODE:
+		return []SymbolPlaying{X_PLAYING, O_PLAYING}
+	case EASY_AI_MODE, MEDIUM_AI_MODE, HARD_AI_MODE:
+		return []SymbolPlaying{g.humanSymbol}
+	case ULTIMATE_MODE, CUBE_MODE:
+		if g.aiOpponent {
+			return []SymbolPlaying{g.humanSymbol}
+		}
+		return []SymbolPlaying{X_PLAYING, O_PLAYING}
+	case ONLINE_MODE:
+		if g.online != nil {
+			return []SymbolPlaying{SymbolPlaying(g.online.Symbol())}
+		}
+	}
+	return nil
+}
+
+// recordGame records the results of the local players in the high scores, in their
+// profiles and in the series, once per game.
+func (g *Game) recordGame() {
+	if g.gameRecorded {
+		return
+	}
+	g.gameRecorded = true
+	if g.series != nil {
+		g.series.Add(rules.Symbol(g.win), g.pointsX, g.pointsO)
+	}
+	g.recordScores()
+	g.recordStats()
+}
+
+// recordScores records the results of the local players of the game in the high scores
+// and saves them.
+func (g *Game) recordScores() {
+	difficulty := difficultyName(g.gameMode)
+	players := g.localPlayers()
+	if difficulty == "" || len(players) == 0 {
+		return
+	}
+	if g.scores == nil {
+		g.loadScores()
+	}
+	g.highScoreMode = g.gameMode
+	g.highScoreRank = -1
+	g.highScoreMessage = ""
+	date := time.Now()
+	for _, symbol := range players {
+		points := g.pointsX
+		if symbol == O_PLAYING {
+			points = g.pointsO
+		}
+		result := g.tallyOf(symbol).Result(g.playerName(symbol), points, date)
+		rank, personalBest := g.scores.Add(rhythm.SongName, difficulty, result)
+		if rank >= 0 && (g.highScoreRank < 0 || rank < g.highScoreRank) {
+			g.highScoreRank = rank
+		}
+		if personalBest {
+			g.highScoreMessage = fmt.Sprintf("New personal best for %s!", result.Player)
+		}
+	}
+	if err := g.scores.Save(); err != nil {
+		log.LogMessage(log.ERROR, "failed to save the scores: "+err.Error())
+	}
+}
+
+// openHighScores opens the high-score screen of the given game mode.
+// The highlighted rank is kept only for the mode of the last recorded game.
+func (g *Game) openHighScores(mode GameMode) {
+	if g.scores == nil {
+		g.loadScores()
+	}
+	if mode != g.highScoreMode {
+		g.highScoreRank = -1
+		g.highScoreMessage = ""
+	}
+	if difficultyName(mode) == "" {
+		mode = GORYTHM_MODE
+	}
+	g.highScoreMode = mode
+	g.state = StateHighScores
+}
+
+// handleStateHighScores handles the high-score screen inputs. The left and right arrows
+// change the difficulty, Enter or Escape returns to the menu.
+func (g *Game) handleStateHighScores() {
+	i := 0
+	for j, mode := range highScoreModes {
+		if mode == g.highScoreMode {
+			i = j
+		}
+	}
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyLeft):
+		g.highScoreMode = highScoreModes[(i+len(highScoreModes)-1)%len(highScoreModes)]
+		g.highScoreRank = -1
+		g.highScoreMessage = ""
+	case inpututil.IsKeyJustPressed(ebiten.KeyRight):
+		g.highScoreMode = highScoreModes[(i+1)%len(highScoreModes)]
+		g.highScoreRank = -1
+		g.highScoreMessage = ""
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnter), inpututil.IsKeyJustPressed(ebiten.KeyEscape):
+		g.state = StateMenu
+	}
+}
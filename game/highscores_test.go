@@ -0,0 +1,60 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/rhythm"
+	"GoRythm/internal/storage"
+	"testing"
+)
+
+// TestGame_recordGame tests the recordGame function after a GoRythm game.
+// Checks if the results of both local players are recorded once with their judgements.
+func TestGame_recordGame(t *testing.T) {
+	storage.SetDir(t.TempDir())
+	defer storage.SetDir("")
+
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.gameMode = GORYTHM_MODE
+	g.pointsX = 600
+	g.pointsO = 100
+	g.tallyOf(X_PLAYING).Add(rhythm.PerfectScore)
+	g.tallyOf(X_PLAYING).Add(rhythm.PerfectScore)
+	g.tallyOf(O_PLAYING).Add(rhythm.GoodScore)
+	g.recordGame()
+	g.recordGame()
+
+	results := g.scores.HighScores(rhythm.SongName, "gorythm")
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+	if results[0].Player != "Player (X)" || results[0].Score != 600 || results[0].Perfect != 2 || results[0].MaxCombo != 2 {
+		t.Errorf("Expected the result of X first, got %v", results[0])
+	}
+	if g.highScoreRank != 0 || g.highScoreMessage == "" {
+		t.Errorf("Expected a new high score and personal best, got rank %d", g.highScoreRank)
+	}
+
+	g.restartGame()
+	if g.gameRecorded || g.tallyX.Hits() != 0 {
+		t.Error("Expected the tallies to be reset for the next game")
+	}
+}
+
+// TestGame_recordGame_spectator tests the recordGame function in Spectator mode.
+// Checks if nothing is recorded when no local player played.
+func TestGame_recordGame_spectator(t *testing.T) {
+	storage.SetDir(t.TempDir())
+	defer storage.SetDir("")
+
+	g := NewGame()
+	g.gameMode = SPECTATOR_MODE
+	g.recordGame()
+	if g.scores != nil {
+		t.Error("Expected no scores to be recorded")
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/ai"
+	"GoRythm/internal/log"
+	"GoRythm/internal/rules"
+	"GoRythm/internal/theme"
+	"errors"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const (
+	hintDuration = 2 * time.Second        // The time the cell of a hint is highlighted
+	hintDeadline = 300 * time.Millisecond // The time the search of a hint has when the game cannot be solved
+)
+
+var (
+	errNoHint = errors.New("no hint for this game")
+)
+
+// canHint returns true if a human player of a local 3x3 game is to move and can ask for a hint.
+func (g *Game) canHint() bool {
+	return g.state == StatePlaying && g.position != nil && g.online == nil && g.gameMode != WATCH_MODE &&
+		g.currentPlayerType == HUMAN_TYPE && !g.position.Over()
+}
+
+// solverHint returns the best move for the current player found by the solver, or errNoHint if
+// the game cannot be solved.
+func (g *Game) solverHint() (rules.Move, error) {
+	s := g.gameSolver()
+	if s == nil {
+		return rules.Move{}, errNoHint
+	}
+	moves, err := s.Moves(g.position.Board, g.position.Turn)
+	if err != nil {
+		return rules.Move{}, err
+	}
+	if len(moves) == 0 {
+		return rules.Move{}, ai.ErrNoMove
+	}
+	return moves[0].Move, nil
+}
+
+// askHint asks for the best move for the current player. It is the move of the solver when the
+// game can be solved, else the best move the minimax search finds before hintDeadline, searched
+// off the game loop and shown by updateHint once found.
+func (g *Game) askHint() {
+	if !g.canHint() {
+		log.LogMessage(log.DEBUG, "hint refused: "+errNoHint.Error())
+		return
+	}
+	if g.hintJob != nil {
+		return
+	}
+	move, err := g.solverHint()
+	if err == nil {
+		g.showHint(move)
+		return
+	}
+	if !errors.Is(err, errNoHint) {
+		log.LogMessage(log.DEBUG, "hint refused: "+err.Error())
+		return
+	}
+	job, err := g.newAIJob("minimax", hintDeadline)
+	if err != nil {
+		log.LogMessage(log.ERROR, "hint failed to start: "+err.Error())
+		return
+	}
+	g.hintJob = job
+	g.hintRound = g.rounds
+}
+
+// updateHint shows the move found by the search of the hint once it is computed. The search is
+// dropped if a move is played meanwhile. It never blocks the game loop.
+func (g *Game) updateHint() {
+	if g.hintJob == nil {
+		return
+	}
+	if g.rounds != g.hintRound || !g.canHint() {
+		g.cancelHint()
+		return
+	}
+	select {
+	case res := <-g.hintJob.results:
+		g.hintJob = nil
+		if res.err != nil {
+			log.LogMessage(log.DEBUG, "hint failed: "+res.err.Error())
+			return
+		}
+		g.showHint(ai.ClassicCell(res.move))
+	default:
+	}
+}
+
+// cancelHint stops the search of the hint, its result is dropped.
+func (g *Game) cancelHint() {
+	if g.hintJob != nil {
+		g.hintJob.cancel()
+		g.hintJob = nil
+	}
+}
+
+// showHint highlights the move until hintDuration has passed or a move is played. The hint is
+// counted in the results of the player.
+func (g *Game) showHint(move rules.Move) {
+	g.hint = move
+	g.hintTime = time.Now()
+	g.hintRound = g.rounds
+	g.tallyOf(g.currentPlayerSymbol).Hints++
+}
+
+// hintShown returns true if the cell of the last hint is highlighted.
+func (g *Game) hintShown() bool {
+	return !g.hintTime.IsZero() && g.rounds == g.hintRound && time.Since(g.hintTime) < hintDuration
+}
+
+// DrawHint draws the cell of the last hint with the symbol to place.
+func (g *Game) DrawHint(screen *ebiten.Image) {
+	cell := float32(g.sWidth) / 3
+	x, y := float32(g.hint.X)*cell, float32(g.hint.Y)*cell
+	vector.StrokeRect(screen, x, y, cell, cell, 4, theme.WinValueColor, false)
+	drawSymbol(screen, g.hint.Symbol, x, y, cell, 3, theme.WinValueColor)
+}
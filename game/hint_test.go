@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/rules"
+	"testing"
+	"time"
+)
+
+// startHintGame returns a local 3x3 game with the variant where X can complete the first column
+// and O the second one, X to move.
+func startHintGame(t *testing.T, variant rules.Variant) *Game {
+	t.Helper()
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.gameMode = CLASSIC_PVP_MODE
+	g.state = StatePlaying
+	g.settings.Rules = variant
+	g.currentPlayerSymbol = X_PLAYING
+	g.currentPlayerType = HUMAN_TYPE
+	g.startPosition()
+	for _, cell := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}} {
+		g.performMove(cell[0], cell[1])
+	}
+	return g
+}
+
+// waitHint updates the hint until it is shown.
+func waitHint(t *testing.T, g *Game) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !g.hintShown() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the hint to be shown, got none")
+		}
+		g.updateHint()
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// TestGame_askHint tests the askHint function with and without the solver.
+// Checks if the winning move is shown and counted, hidden after a move, and refused to the AI.
+func TestGame_askHint(t *testing.T) {
+	for _, variant := range []rules.Variant{{}, {MaxSymbols: 4}} {
+		g := startHintGame(t, variant)
+		g.askHint()
+		waitHint(t, g)
+		want := rules.Move{X: 0, Y: 2, Symbol: rules.X}
+		if !g.hintShown() || g.hint != want || g.tallyX.Hints != 1 {
+			t.Errorf("Expected the hint %v counted for X with %s, got %v and %d hints", want, variant, g.hint, g.tallyX.Hints)
+		}
+		g.performMove(2, 2)
+		if g.hintShown() {
+			t.Errorf("Expected the hint to be hidden after a move with %s", variant)
+		}
+
+		g.currentPlayerType = AI_TYPE
+		g.askHint()
+		if g.tallyO.Hints != 0 {
+			t.Errorf("Expected no hint for the AI with %s, got %d", variant, g.tallyO.Hints)
+		}
+	}
+}
+
+// TestGame_askHint_search tests the askHint function when the game cannot be solved.
+// Checks if the search runs off the game loop and is dropped when a move is played meanwhile.
+func TestGame_askHint_search(t *testing.T) {
+	g := startHintGame(t, rules.Variant{MaxSymbols: 3})
+	start := time.Now()
+	g.askHint()
+	if elapsed := time.Since(start); elapsed > hintDeadline/10 {
+		t.Errorf("Expected askHint to return at once, got %v", elapsed)
+	}
+	if g.hintJob == nil || g.hintShown() || g.tallyX.Hints != 0 {
+		t.Errorf("Expected the hint to be searched and not shown yet, got %d hints", g.tallyX.Hints)
+	}
+
+	g.performMove(2, 2)
+	g.updateHint()
+	if g.hintJob != nil || g.hintShown() || g.tallyX.Hints != 0 {
+		t.Errorf("Expected the search dropped after a move, got %d hints", g.tallyX.Hints)
+	}
+}
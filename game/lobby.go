@@ -0,0 +1,180 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/discovery"
+	"GoRythm/internal/log"
+	"GoRythm/internal/network"
+	"GoRythm/internal/rhythm"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+const (
+	lobbyHostAddress = ":0" // The address of the server hosted from the lobby, on any free port
+)
+
+// openLobby opens the lobby and starts listening to the games announced on the local network.
+// The manual address entry stays available when the discovery fails (in a browser for example).
+func (g *Game) openLobby() {
+	g.state = StateLobby
+	g.gameMode = ONLINE_MODE
+	g.lobbySelected = 0
+	g.lobbyTyping = false
+	g.lobbyAddress = g.serverAddress
+	g.onlineError = ""
+	browser, err := discovery.NewBrowser(g.discoveryAddress)
+	if err != nil {
+		log.LogMessage(log.WARN, "failed to discover the games: "+err.Error())
+		g.onlineError = "Discovery unavailable, press M to enter an address"
+		return
+	}
+	g.browser = browser
+}
+
+// closeLobby stops listening to the announced games.
+func (g *Game) closeLobby() {
+	if g.browser != nil {
+		g.browser.Close()
+		g.browser = nil
+	}
+	g.lobbyGames = nil
+}
+
+// handleStateLobby handles the lobby inputs. A listed game is selected with the arrows and
+// joined with Enter, or watched with S. H and C host a GoRythm or Classic game, M enters an
+// address manually and Escape returns to the menu.
+func (g *Game) handleStateLobby() {
+	if g.browser != nil {
+		g.lobbyGames = g.browser.Games()
+	}
+	if g.lobbySelected >= len(g.lobbyGames) {
+		g.lobbySelected = max(len(g.lobbyGames)-1, 0)
+	}
+	if g.lobbyTyping {
+		g.handleLobbyTyping()
+		return
+	}
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyEscape):
+		g.closeLobby()
+		g.onlineError = ""
+		g.state = StateMenu
+	case inpututil.IsKeyJustPressed(ebiten.KeyUp):
+		g.lobbySelected = max(g.lobbySelected-1, 0)
+	case inpututil.IsKeyJustPressed(ebiten.KeyDown):
+		g.lobbySelected = min(g.lobbySelected+1, max(len(g.lobbyGames)-1, 0))
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnter):
+		if g.lobbySelected < len(g.lobbyGames) {
+			g.joinOnline(ONLINE_MODE, g.lobbyGames[g.lobbySelected].Addr)
+		}
+	case inpututil.IsKeyJustPressed(ebiten.KeyS):
+		if g.lobbySelected < len(g.lobbyGames) {
+			g.joinOnline(SPECTATOR_MODE, g.lobbyGames[g.lobbySelected].Addr)
+		}
+	case inpututil.IsKeyJustPressed(ebiten.KeyH):
+		g.hostOnline(network.GoRythmMode)
+	case inpututil.IsKeyJustPressed(ebiten.KeyC):
+		g.hostOnline(network.ClassicMode)
+	case inpututil.IsKeyJustPressed(ebiten.KeyM):
+		g.lobbyTyping = true
+	}
+}
+
+// handleLobbyTyping handles the manual entry of a server address.
+// Enter joins the server and Escape cancels the entry.
+func (g *Game) handleLobbyTyping() {
+	g.lobbyAddress += string(ebiten.AppendInputChars(nil))
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(g.lobbyAddress) > 0 {
+		runes := []rune(g.lobbyAddress)
+		g.lobbyAddress = string(runes[:len(runes)-1])
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.lobbyTyping = false
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) && g.lobbyAddress != "" {
+		g.lobbyTyping = false
+		g.joinOnline(ONLINE_MODE, g.lobbyAddress)
+	}
+}
+
+// joinOnline connects to the server at the given address to play (ONLINE_MODE) or watch
+// (SPECTATOR_MODE) a match, and leaves the lobby. The lobby stays open with the error on failure.
+func (g *Game) joinOnline(mode GameMode, addr string) {
+	g.gameMode = mode
+	g.serverAddress = addr
+	if err := g.connectOnline(); err != nil {
+		log.LogMessage(log.ERROR, err.Error())
+		g.stopHost()
+		g.gameMode = ONLINE_MODE
+		g.onlineError = err.Error()
+		return
+	}
+	g.closeLobby()
+	g.state = StateLoading
+	g.countdownTime = time.Now()
+}
+
+// hostOnline hosts a game with the given rules on the local machine, announces it on the
+// local network and joins it as the first player.
+func (g *Game) hostOnline(mode network.Mode) {
+	if err := g.startHost(mode); err != nil {
+		log.LogMessage(log.ERROR, err.Error())
+		g.onlineError = err.Error()
+		return
+	}
+	g.joinOnline(ONLINE_MODE, fmt.Sprintf("127.0.0.1:%d", g.host.Port()))
+}
+
+// startHost starts the server and the announcer of a hosted game.
+func (g *Game) startHost(mode network.Mode) error {
+	beatMap, err := rhythm.LoadBeatmap()
+	if err != nil {
+		return fmt.Errorf("failed to load beatmap: %w", err)
+	}
+	server := network.NewServer(mode, beatMap)
+	if err := server.Listen(lobbyHostAddress); err != nil {
+		return err
+	}
+	go server.Serve()
+	name := g.hostName()
+	announcer, err := discovery.NewAnnouncer(g.discoveryAddress, func() discovery.Announcement {
+		return discovery.ServerAnnouncement(name, server)
+	})
+	if err != nil {
+		server.Close()
+		return err
+	}
+	announcer.Start()
+	g.host = server
+	g.announcer = announcer
+	log.LogMessage(log.INFO, fmt.Sprintf("Hosting %s matches on %s", mode, server.Addr()))
+	return nil
+}
+
+// stopHost stops the server and the announcer of the hosted game, if any.
+func (g *Game) stopHost() {
+	if g.announcer != nil {
+		g.announcer.Close()
+		g.announcer = nil
+	}
+	if g.host != nil {
+		g.host.Close()
+		g.host = nil
+	}
+}
+
+// hostName returns the name of the hosted games in the lobby, the name of the machine
+// or else the name of the player.
+func (g *Game) hostName() string {
+	if name, err := os.Hostname(); err == nil && name != "" {
+		return name
+	}
+	return g.settings.PlayerName
+}
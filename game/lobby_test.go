@@ -0,0 +1,72 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/discovery"
+	"GoRythm/internal/network"
+	"testing"
+	"time"
+)
+
+// TestGame_hostOnline tests the hostOnline function on the loopback interface.
+// Checks if the hosted game is announced with its host as the first player, and stopped on disconnection.
+func TestGame_hostOnline(t *testing.T) {
+	browser, err := discovery.NewBrowser("127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer browser.Close()
+
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.SetDiscoveryAddress(browser.Addr())
+	g.state = StateLobby
+	g.hostOnline(network.ClassicMode)
+	if g.host == nil || g.online == nil {
+		t.Fatalf("Expected to host and join the game, got error %q", g.onlineError)
+	}
+	if g.state != StateLoading {
+		t.Errorf("Expected the loading state, got %v", g.state)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		games := browser.Games()
+		if len(games) == 1 && games[0].Players == 1 {
+			if games[0].Mode != string(network.ClassicMode) || !games[0].Open {
+				t.Errorf("Expected an open classic game, got %v", games[0].Announcement)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the hosted game with 1 player, got %v", games)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	g.disconnectOnline()
+	if g.host != nil || g.announcer != nil {
+		t.Error("Expected the hosted game to be stopped")
+	}
+}
+
+// TestGame_joinOnline tests the joinOnline function with an unreachable server.
+// Checks if the lobby stays open with the error.
+func TestGame_joinOnline(t *testing.T) {
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.state = StateLobby
+	g.joinOnline(ONLINE_MODE, "127.0.0.1:1")
+	if g.state != StateLobby || g.online != nil {
+		t.Errorf("Expected to stay in the lobby, got state %v", g.state)
+	}
+	if g.onlineError == "" {
+		t.Error("Expected the connection error to be shown")
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	board "GoRythm/internal/generation"
+	"GoRythm/internal/log"
+	"GoRythm/internal/network"
+	"GoRythm/internal/rhythm"
+	"GoRythm/internal/rules"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+const (
+	lateStartPosition = 100 * time.Millisecond // The delay after which the music is moved to the match time
+)
+
+// connectOnline connects to the server for the Online mode, or to watch a match in Spectator mode.
+// The rules of the match (Classic or GoRythm) are chosen by the server.
+func (g *Game) connectOnline() error {
+	role := network.PlayerRole
+	if g.gameMode == SPECTATOR_MODE {
+		role = network.SpectatorRole
+	}
+	client, err := network.Dial(g.serverAddress, g.playerName(X_PLAYING), role)
+	if err != nil {
+		return err
+	}
+	g.online = client
+	g.onlineStart = time.Time{}
+	g.serverStart = time.Time{}
+	g.onlineSongEnd = false
+	g.onlineError = ""
+	g.judgement = ""
+	g.spectators = 0
+	g.goRythm = nil
+	if client.Mode() == network.GoRythmMode {
+		g.goRythm = NewGoRythm()
+	}
+	log.LogMessage(log.INFO, fmt.Sprintf("Connected to %s as %s %s", g.serverAddress, client.Role(), client.Symbol()))
+	return nil
+}
+
+// disconnectOnline closes the connection to the server, if any, and stops the hosted game.
+func (g *Game) disconnectOnline() {
+	if g.online != nil {
+		g.online.Close()
+		g.online = nil
+	}
+	g.stopHost()
+}
+
+// isOnlineMode returns true if the match is played or watched on a server.
+func (g *Game) isOnlineMode() bool {
+	return g.gameMode == ONLINE_MODE || g.gameMode == SPECTATOR_MODE
+}
+
+// isSpectating returns true if the local player only watches an online match.
+func (g *Game) isSpectating() bool {
+	return g.online != nil && g.online.Role() == network.SpectatorRole
+}
+
+// isRythmMode returns true if the symbols vanish and the hits are scored on the beat,
+// locally in GoRythm mode or in an online GoRythm match.
+func (g *Game) isRythmMode() bool {
+	return g.gameMode == GORYTHM_MODE || (g.isOnlineMode() && g.goRythm != nil)
+}
+
+// handleOnlineLoading waits for an opponent then counts down until the music starts
+// at the time given by the server. A spectator joining a running match starts the music
+// at the current time of the match.
+func (g *Game) handleOnlineLoading() error {
+	g.pollOnline()
+	if g.state != StateLoading || g.onlineStart.IsZero() {
+		return nil
+	}
+	g.syncOnlineStart()
+	g.countdown = int(math.Ceil(time.Until(g.onlineStart).Seconds()))
+	if g.countdown <= 0 {
+		g.state = StatePlaying
+		if g.goRythm != nil {
+			g.goRythm.Start(g.onlineStart)
+		}
+		if g.audioPlayer == nil {
+			return fmt.Errorf("audio player is nil")
+		}
+		if late := time.Since(g.onlineStart); late > lateStartPosition {
+			if err := g.audioPlayer.SetPosition(late); err != nil {
+				return err
+			}
+		}
+		g.audioPlayer.Play()
+	}
+	return nil
+}
+
+// handleOnlinePlaying sends the moves of the local player to the server. The board is
+// only changed by the states received from the server, which is authoritative.
+func (g *Game) handleOnlinePlaying() error {
+	g.pollOnline()
+	if g.state != StatePlaying || g.isSpectating() {
+		return nil
+	}
+	// Tell the server when the music ends so it can judge the winner on score
+	if !g.audioPlayer.IsPlaying() && !g.onlineSongEnd {
+		g.onlineSongEnd = true
+		if err := g.online.SendSongEnd(); err != nil {
+			log.LogMessage(log.WARN, "failed to send the song end: "+err.Error())
+		}
+	}
+	if g.currentPlayerSymbol != SymbolPlaying(g.online.Symbol()) {
+		return nil
+	}
+	for key, pos := range keyboardToBoard {
+		if inpututil.IsKeyJustPressed(key) {
+			x, y := pos[0], pos[1]
+			if g.board[x][y] == NONE_PLAYING {
+				hitTime := g.onlineSongTime()
+				if err := g.online.SendMove(x, y, hitTime); err != nil {
+					log.LogMessage(log.WARN, "failed to send the move: "+err.Error())
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// onlineSongTime returns the current music time (in seconds) on the timeline shared with
+// the server, used to judge the hits.
+func (g *Game) onlineSongTime() float64 {
+	if g.online.Synced() && !g.serverStart.IsZero() {
+		return g.online.ServerTime(time.Now()).Sub(g.serverStart).Seconds()
+	}
+	return time.Since(g.onlineStart).Seconds()
+}
+
+// syncOnlineStart converts the server time the music starts to the local clock once the clock
+// is synchronised with the server. The second player receives the start right after joining,
+// before any synchronisation.
+func (g *Game) syncOnlineStart() {
+	if !g.serverStart.IsZero() && g.online.Synced() {
+		g.onlineStart = g.online.LocalTime(g.serverStart)
+	}
+}
+
+// pollOnline applies the messages received from the server without blocking.
+func (g *Game) pollOnline() {
+	for {
+		select {
+		case msg, ok := <-g.online.Events():
+			if !ok {
+				g.endOnline("Connection lost")
+				return
+			}
+			g.handleOnlineMessage(msg)
+		default:
+			return
+		}
+	}
+}
+
+// handleOnlineMessage applies a message received from the server.
+func (g *Game) handleOnlineMessage(msg network.Message) {
+	switch msg.Type {
+	case network.MsgStart:
+		// Count from the reception until the clock is synchronised, see syncOnlineStart
+		if msg.StartAt != 0 {
+			g.serverStart = time.Unix(0, msg.StartAt)
+		}
+		g.onlineStart = time.Now().Add(time.Duration(msg.StartIn) * time.Millisecond)
+		g.syncOnlineStart()
+		g.applyOnlineState(msg.State)
+	case network.MsgState:
+		g.applyOnlineState(msg.State)
+	case network.MsgReject:
+		log.LogMessage(log.DEBUG, "move refused: "+msg.Reason)
+	case network.MsgGameOver:
+		g.applyOnlineState(msg.State)
+		g.win = SymbolPlaying(msg.State.Winner)
+		g.state = StateGameOver
+	case network.MsgLeft:
+		g.endOnline("Opponent left")
+	}
+}
+
+// endOnline ends the online match with an error message.
+func (g *Game) endOnline(reason string) {
+	g.onlineError = reason
+	if g.state != StateGameOver {
+		g.state = StateGameOver
+	}
+}
+
+// applyOnlineState copies the state received from the server and redraws the board.
+func (g *Game) applyOnlineState(state *network.State) {
+	if state == nil {
+		return
+	}
+	for x := 0; x < 3; x++ {
+		for y := 0; y < 3; y++ {
+			g.board[x][y] = SymbolPlaying(state.Board[x][y])
+		}
+	}
+	if g.goRythm != nil && state.Rounds > g.rounds && state.LastSymbol != rules.None {
+		g.judgement = fmt.Sprintf("%s: %s", state.LastSymbol, rhythm.Label(state.LastJudgement))
+		g.tallyOf(SymbolPlaying(state.LastSymbol)).AddTimed(state.LastJudgement, state.LastOffset)
+	}
+	g.currentPlayerSymbol = SymbolPlaying(state.Turn)
+	g.pointsX = state.PointsX
+	g.pointsO = state.PointsO
+	g.rounds = state.Rounds
+	g.spectators = state.Spectators
+	g.onlineQueues = map[SymbolPlaying]*rules.MoveQueue{X_PLAYING: state.QueueX, O_PLAYING: state.QueueO}
+	g.redrawBoard(state.NextRemovalX, state.NextRemovalO)
+}
+
+// redrawBoard draws all the symbols of the board on the game image,
+// the symbols at the given positions are highlighted.
+func (g *Game) redrawBoard(highlightedX, highlightedO []int) {
+	g.gameImage.Clear()
+	for x := 0; x < 3; x++ {
+		for y := 0; y < 3; y++ {
+			var image *ebiten.Image
+			switch g.board[x][y] {
+			case X_PLAYING:
+				image = g.XImage
+				if isPosition(highlightedX, x, y) {
+					image = g.XImageHighlighted
+				}
+			case O_PLAYING:
+				image = g.OImage
+				if isPosition(highlightedO, x, y) {
+					image = g.OImageHighlighted
+				}
+			default:
+				continue
+			}
+			options := &ebiten.DrawImageOptions{}
+			options.GeoM.Translate(float64(x*board.CellSize), float64(y*board.CellSize))
+			g.gameImage.DrawImage(image, options)
+		}
+	}
+}
+
+// isPosition returns true if the position pos is [x, y].
+func isPosition(pos []int, x, y int) bool {
+	return len(pos) == 2 && pos[0] == x && pos[1] == y
+}
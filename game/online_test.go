@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/network"
+	"GoRythm/internal/rules"
+	"testing"
+)
+
+// TestGame_connectOnline tests the connectOnline function with a loopback server.
+// Checks if the client is connected and if the GoRythm rules are enabled by the server mode.
+func TestGame_connectOnline(t *testing.T) {
+	server := network.NewServer(network.GoRythmMode, nil)
+	if err := server.Listen("127.0.0.1:0"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	go server.Serve()
+	defer server.Close()
+
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.gameMode = ONLINE_MODE
+	g.SetServerAddress(server.Addr())
+	if err := g.connectOnline(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer g.disconnectOnline()
+	if g.online == nil {
+		t.Fatal("Expected the client to be connected, got nil")
+	}
+	if !g.isRythmMode() {
+		t.Error("Expected the GoRythm rules in a GoRythm online match")
+	}
+	if g.isSpectating() {
+		t.Error("Expected to play in Online mode, not to spectate")
+	}
+}
+
+// TestGame_connectSpectator tests the connectOnline function in Spectator mode.
+// Checks if the client watches the match without symbol.
+func TestGame_connectSpectator(t *testing.T) {
+	server := network.NewServer(network.ClassicMode, nil)
+	if err := server.Listen("127.0.0.1:0"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	go server.Serve()
+	defer server.Close()
+
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.gameMode = SPECTATOR_MODE
+	g.SetServerAddress(server.Addr())
+	if err := g.connectOnline(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer g.disconnectOnline()
+	if !g.isSpectating() {
+		t.Error("Expected to spectate in Spectator mode")
+	}
+	if g.isRythmMode() {
+		t.Error("Expected the Classic rules in a Classic online match")
+	}
+}
+
+// TestGame_applyOnlineState tests the applyOnlineState function.
+// Checks if the board, turn and scores are copied from the server state.
+func TestGame_applyOnlineState(t *testing.T) {
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	state := &network.State{
+		Board:        rules.Board{{rules.X, rules.None, rules.None}, {rules.None, rules.O, rules.None}},
+		Turn:         rules.O,
+		PointsX:      300,
+		PointsO:      50,
+		Rounds:       2,
+		NextRemovalX: []int{0, 0},
+	}
+	g.applyOnlineState(state)
+	if g.board[0][0] != X_PLAYING || g.board[1][1] != O_PLAYING {
+		t.Errorf("Expected the board to be copied, got %v", g.board)
+	}
+	if g.currentPlayerSymbol != O_PLAYING {
+		t.Errorf("Expected O to play, got %s", g.currentPlayerSymbol)
+	}
+	if g.pointsX != 300 || g.pointsO != 50 || g.rounds != 2 {
+		t.Errorf("Expected the scores and rounds to be copied, got %d, %d and %d", g.pointsX, g.pointsO, g.rounds)
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/log"
+	"GoRythm/internal/profiles"
+	"GoRythm/internal/storage"
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+const (
+	exportJSONName = "stats.json" // The name of the JSON export of the statistics in the storage
+	exportCSVName  = "stats.csv"  // The name of the CSV export of the statistics in the storage
+	onlineOpponent = "online"     // The opponent name of the online matches in the profiles
+)
+
+// profileStore returns the player profiles, loaded on the first call.
+// A store without profiles is used on error.
+func (g *Game) profileStore() *profiles.Store {
+	if g.profiles == nil {
+		store, err := profiles.Load()
+		if err != nil {
+			log.LogMessage(log.ERROR, "failed to load the profiles: "+err.Error())
+		}
+		g.profiles = store
+	}
+	return g.profiles
+}
+
+// profileName returns the name of the profile of the given player, or an empty string.
+// The second profile plays O when two players share this machine, the first profile plays
+// every other symbol.
+func (g *Game) profileName(symbol SymbolPlaying) string {
+	store := g.profileStore()
+	if symbol == O_PLAYING && len(g.localPlayers()) > 1 {
+		return store.Player2
+	}
+	return store.Player1
+}
+
+// playerName returns the name of the given player, the name of its profile or the player
+// name of the settings. The symbol is added to the name when two players share this machine
+// under the same name.
+func (g *Game) playerName(symbol SymbolPlaying) string {
+	name := g.profileName(symbol)
+	if name == "" {
+		name = g.settings.PlayerName
+	}
+	if len(g.localPlayers()) > 1 && g.profileName(X_PLAYING) == g.profileName(O_PLAYING) {
+		return fmt.Sprintf("%s (%s)", name, symbol)
+	}
+	return name
+}
+
+// outcome returns the outcome of the game for the given player.
+func (g *Game) outcome(symbol SymbolPlaying) profiles.Outcome {
+	switch g.win {
+	case NONE_PLAYING:
+		return profiles.Draw
+	case symbol:
+		return profiles.Win
+	}
+	return profiles.Loss
+}
+
+// recordStats records the game in the profiles of the local players and saves them.
+// The opponent is the AI level, the other local player or the online opponent.
+func (g *Game) recordStats() {
+	players := g.localPlayers()
+	if len(players) == 0 {
+		return
+	}
+	store := g.profileStore()
+	recorded := false
+	for _, symbol := range players {
+		profile := store.Get(g.profileName(symbol))
+		if profile == nil {
+			continue
+		}
+		tally := g.tallyOf(symbol)
+		game := profiles.Game{
+			Outcome:   g.outcome(symbol),
+			Hits:      tally.Hits(),
+			BeatsHit:  tally.Hits() - tally.Miss,
+			OffsetSum: tally.OffsetSum,
+			Accuracy:  tally.Accuracy(),
+			Date:      time.Now(),
+		}
+		switch {
+		case g.hasAI():
+			game.AI = true
+			game.Opponent = g.aiName()
+		case len(players) > 1:
+			opponent := X_PLAYING
+			if symbol == X_PLAYING {
+				opponent = O_PLAYING
+			}
+			game.Opponent = g.playerName(opponent)
+		default:
+			game.Opponent = onlineOpponent
+		}
+		profile.AddGame(game)
+		recorded = true
+	}
+	if recorded {
+		if err := store.Save(); err != nil {
+			log.LogMessage(log.ERROR, "failed to save the profiles: "+err.Error())
+		}
+	}
+}
+
+// openProfiles opens the profiles screen.
+func (g *Game) openProfiles() {
+	g.profileStore()
+	g.profileSelected = 0
+	g.profileTyping = false
+	g.profileMessage = ""
+	g.state = StateProfiles
+}
+
+// selectedProfile returns the profile selected on the profiles screen, or nil.
+func (g *Game) selectedProfile() *profiles.Profile {
+	names := g.profileStore().Names()
+	if g.profileSelected < 0 || g.profileSelected >= len(names) {
+		return nil
+	}
+	return g.profiles.Get(names[g.profileSelected])
+}
+
+// handleStateProfiles handles the profiles screen inputs. A profile is selected with the arrows,
+// 1 and 2 make it the first or second local player, N creates a profile, D deletes it, J and C
+// export the statistics as JSON or CSV, and Escape saves and returns to the menu.
+func (g *Game) handleStateProfiles() {
+	if g.profileTyping {
+		g.handleProfileTyping()
+		return
+	}
+	store := g.profileStore()
+	profile := g.selectedProfile()
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyUp):
+		g.profileSelected = max(g.profileSelected-1, 0)
+	case inpututil.IsKeyJustPressed(ebiten.KeyDown):
+		g.profileSelected = min(g.profileSelected+1, max(len(store.Profiles)-1, 0))
+	case inpututil.IsKeyJustPressed(ebiten.Key1) && profile != nil:
+		store.Player1 = profile.Name
+		g.saveProfiles(fmt.Sprintf("%s is player 1", profile.Name))
+	case inpututil.IsKeyJustPressed(ebiten.Key2) && profile != nil:
+		store.Player2 = profile.Name
+		g.saveProfiles(fmt.Sprintf("%s is player 2", profile.Name))
+	case inpututil.IsKeyJustPressed(ebiten.KeyN):
+		g.profileTyping = true
+		g.profileInput = ""
+	case inpututil.IsKeyJustPressed(ebiten.KeyD) && profile != nil:
+		if err := store.Delete(profile.Name); err == nil {
+			g.profileSelected = max(g.profileSelected-1, 0)
+			g.saveProfiles(fmt.Sprintf("%s deleted", profile.Name))
+		}
+	case inpututil.IsKeyJustPressed(ebiten.KeyJ):
+		g.exportProfiles(exportJSONName, store.ExportJSON)
+	case inpututil.IsKeyJustPressed(ebiten.KeyC):
+		g.exportProfiles(exportCSVName, store.ExportCSV)
+	case inpututil.IsKeyJustPressed(ebiten.KeyEscape), inpututil.IsKeyJustPressed(ebiten.KeyEnter):
+		g.state = StateMenu
+	}
+}
+
+// handleProfileTyping handles the typing of the name of a new profile.
+// Enter creates the profile and Escape cancels it.
+func (g *Game) handleProfileTyping() {
+	g.profileInput += string(ebiten.AppendInputChars(nil))
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(g.profileInput) > 0 {
+		runes := []rune(g.profileInput)
+		g.profileInput = string(runes[:len(runes)-1])
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.profileTyping = false
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		g.profileTyping = false
+		g.createProfile(g.profileInput)
+	}
+}
+
+// createProfile creates a profile and selects it. The first profile becomes player 1.
+func (g *Game) createProfile(name string) {
+	store := g.profileStore()
+	profile, err := store.Create(name, time.Now())
+	if err != nil {
+		g.profileMessage = err.Error()
+		return
+	}
+	if store.Player1 == "" {
+		store.Player1 = profile.Name
+	}
+	for i, n := range store.Names() {
+		if n == profile.Name {
+			g.profileSelected = i
+		}
+	}
+	g.saveProfiles(fmt.Sprintf("%s created", profile.Name))
+}
+
+// saveProfiles saves the profiles and shows the message, or the error.
+func (g *Game) saveProfiles(message string) {
+	if err := g.profileStore().Save(); err != nil {
+		log.LogMessage(log.ERROR, "failed to save the profiles: "+err.Error())
+		g.profileMessage = err.Error()
+		return
+	}
+	g.profileMessage = message
+}
+
+// exportProfiles exports the statistics of the profiles in the storage under the given name.
+func (g *Game) exportProfiles(name string, export func(w io.Writer) error) {
+	var buffer bytes.Buffer
+	if err := export(&buffer); err != nil {
+		g.profileMessage = err.Error()
+		return
+	}
+	if err := storage.Save(name, buffer.Bytes()); err != nil {
+		log.LogMessage(log.ERROR, "failed to export the statistics: "+err.Error())
+		g.profileMessage = err.Error()
+		return
+	}
+	dir, _ := storage.Dir()
+	g.profileMessage = "Exported to " + filepath.Join(dir, name)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/profiles"
+	"GoRythm/internal/rhythm"
+	"GoRythm/internal/storage"
+	"testing"
+	"time"
+)
+
+// TestGame_recordStats tests the recordStats function after a local GoRythm game.
+// Checks if the PvP records, beats hit and accuracy of both profiles are updated.
+func TestGame_recordStats(t *testing.T) {
+	storage.SetDir(t.TempDir())
+	defer storage.SetDir("")
+
+	g := NewGame()
+	store := g.profileStore()
+	for _, name := range []string{"alice", "bob"} {
+		if _, err := store.Create(name, time.Now()); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	store.Player1 = "alice"
+	store.Player2 = "bob"
+	g.gameMode = GORYTHM_MODE
+	g.win = X_PLAYING
+	g.tallyOf(X_PLAYING).AddTimed(rhythm.PerfectScore, 0.02)
+	g.tallyOf(O_PLAYING).AddTimed(rhythm.MissedScore, -0.5)
+	g.recordStats()
+
+	alice := store.Get("alice")
+	if alice.PvP["bob"] != (profiles.Record{Wins: 1}) || alice.BeatsHit != 1 || alice.Hits != 1 {
+		t.Errorf("Expected a win against bob with 1 beat hit, got %v", alice)
+	}
+	bob := store.Get("bob")
+	if bob.PvP["alice"] != (profiles.Record{Losses: 1}) || bob.BeatsHit != 0 || bob.Hits != 1 {
+		t.Errorf("Expected a loss against alice without beat hit, got %v", bob)
+	}
+	if g.playerName(O_PLAYING) != "bob" {
+		t.Errorf("Expected O to be bob, got %s", g.playerName(O_PLAYING))
+	}
+}
+
+// TestGame_recordStats_ai tests the recordStats function after a game against the AI.
+// Checks if the record against the AI level of the profile of player 1 is updated.
+func TestGame_recordStats_ai(t *testing.T) {
+	storage.SetDir(t.TempDir())
+	defer storage.SetDir("")
+
+	g := NewGame()
+	g.createProfile("alice")
+	g.gameMode = HARD_AI_MODE
+	g.humanSymbol = O_PLAYING
+	g.win = NONE_PLAYING
+	g.recordStats()
+
+	loaded, err := profiles.Load()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if loaded.Player1 != "alice" || loaded.Get("alice").VsAI["hard"] != (profiles.Record{Draws: 1}) {
+		t.Errorf("Expected a draw against hard for alice, got %v", loaded.Get("alice"))
+	}
+}
@@ -4,10 +4,16 @@
 package game
 
 import (
+	"GoRythm/internal/clock"
 	"GoRythm/internal/log"
+	"GoRythm/internal/profiles"
+	"GoRythm/internal/rhythm"
+	"GoRythm/internal/rules"
 	t "GoRythm/internal/text"
 	"GoRythm/internal/theme"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/fogleman/gg"
@@ -16,12 +22,44 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
+const (
+	summaryGames = 12 // The number of games listed on the series summary
+)
+
 // Draw draws the game elements based on the current state.
 func (g *Game) Draw(screen *ebiten.Image) {
 	if g.state == StateMenu {
 		g.DrawMenu(screen)
 		return
 	}
+	if g.state == StateLobby {
+		g.DrawLobby(screen)
+		return
+	}
+	if g.state == StateSettings {
+		g.DrawSettings(screen)
+		return
+	}
+	if g.state == StateHighScores {
+		g.DrawHighScores(screen)
+		return
+	}
+	if g.state == StateProfiles {
+		g.DrawProfiles(screen)
+		return
+	}
+	if g.state == StateSeriesSummary {
+		g.DrawSeriesSummary(screen)
+		return
+	}
+	if g.state == StateRules {
+		g.DrawRules(screen)
+		return
+	}
+	if g.state == StateReview {
+		g.DrawReview(screen)
+		return
+	}
 	if g.state == StateLoading {
 		g.DrawTimer(screen)
 	}
@@ -29,6 +67,9 @@ func (g *Game) Draw(screen *ebiten.Image) {
 	if g.state == StatePlaying {
 		g.DrawGame(screen)
 	}
+	if g.state == StatePause {
+		g.DrawPause(screen)
+	}
 	if g.state == StateGameOver {
 		g.DrawGameOver(screen)
 	}
@@ -40,35 +81,247 @@ func (g *Game) DrawMenu(screen *ebiten.Image) {
 	t.DrawText(screen, msgTitle, t.BigText, 30, 100, theme.TextColor)
 	msgDifficulty := "Choose difficulty:"
 	t.DrawText(screen, msgDifficulty, t.NormalText, 70, 200, theme.TextColor)
+	msgOpponent := "A. Opponent - Human"
+	if g.aiOpponent {
+		msgOpponent = "A. Opponent - AI"
+	}
+	t.DrawText(screen, msgOpponent, t.NormalText, 270, 200, theme.TextColor)
+	t.DrawText(screen, "R. Rules - "+g.settings.Rules.String(), t.NormalText, 270, 235, theme.TextColor)
+	msgSeries := "TAB. Series - " + g.seriesConfig().String()
+	t.DrawText(screen, msgSeries, t.NormalText, 70, 170, theme.TextColor)
+	msgClock := "T. Clock - " + g.timeControl().String()
+	t.DrawText(screen, msgClock, t.NormalText, 270, 170, theme.TextColor)
 
 	// Highlight the selected difficulty
 	colorClassic := theme.TextColor
 	colorEasy := theme.TextColor
+	colorMedium := theme.TextColor
 	colorHard := theme.TextColor
 	colorGoRythm := theme.TextColor
+	colorOnline := theme.TextColor
+	colorSpectator := theme.TextColor
+	colorUltimate := theme.TextColor
+	colorCube := theme.TextColor
+	colorWatch := theme.TextColor
 
 	switch g.gameMode {
 	case CLASSIC_PVP_MODE:
 		colorClassic = theme.SelectedTextColor
 	case EASY_AI_MODE:
 		colorEasy = theme.SelectedTextColor
+	case MEDIUM_AI_MODE:
+		colorMedium = theme.SelectedTextColor
 	case HARD_AI_MODE:
 		colorHard = theme.SelectedTextColor
 	case GORYTHM_MODE:
 		colorGoRythm = theme.SelectedTextColor
+	case ONLINE_MODE:
+		colorOnline = theme.SelectedTextColor
+	case SPECTATOR_MODE:
+		colorSpectator = theme.SelectedTextColor
+	case ULTIMATE_MODE:
+		colorUltimate = theme.SelectedTextColor
+	case CUBE_MODE:
+		colorCube = theme.SelectedTextColor
+	case WATCH_MODE:
+		colorWatch = theme.SelectedTextColor
 	}
 
-	t.DrawText(screen, "1. PVP - Classic", t.NormalText, 70, 250, colorClassic)
-	t.DrawText(screen, "2. Easy", t.NormalText, 70, 300, colorEasy)
-	t.DrawText(screen, "3. Hard", t.NormalText, 70, 350, colorHard)
-	t.DrawText(screen, "4. GoRythm", t.NormalText, 70, 400, colorGoRythm)
+	t.DrawText(screen, "1. PVP - Classic", t.NormalText, 70, 235, colorClassic)
+	t.DrawText(screen, "2. Easy", t.NormalText, 70, 270, colorEasy)
+	t.DrawText(screen, "3. Hard", t.NormalText, 70, 305, colorHard)
+	t.DrawText(screen, fmt.Sprintf("M. Medium - level %d", g.aiLevel), t.NormalText, 270, 270, colorMedium)
+	t.DrawText(screen, "4. GoRythm", t.NormalText, 70, 340, colorGoRythm)
+	t.DrawText(screen, "5. Online - Lobby", t.NormalText, 70, 375, colorOnline)
+	t.DrawText(screen, "6. Spectate - "+g.serverAddress, t.NormalText, 70, 410, colorSpectator)
+	t.DrawText(screen, "U. Ultimate", t.NormalText, 70, 445, colorUltimate)
+	msgCube := fmt.Sprintf("C. 3D - %dx%dx%d", g.cubeSize, g.cubeSize, g.cubeSize)
+	t.DrawText(screen, msgCube, t.NormalText, 70, 480, colorCube)
+	t.DrawText(screen, "W. Watch - "+g.watchLabel(), t.NormalText, 70, 515, colorWatch)
+	t.DrawText(screen, "7. Settings", t.NormalText, 70, 550, theme.TextColor)
+	t.DrawText(screen, "8. High scores", t.NormalText, 70, 600, theme.TextColor)
+	t.DrawText(screen, "9. Profiles - "+g.playerName(X_PLAYING), t.NormalText, 70, 650, theme.TextColor)
+	if g.onlineError != "" {
+		t.DrawText(screen, g.onlineError, t.NormalText, 70, 680, theme.SelectedTextColor)
+	}
 
 	msgStart := "Press ENTER to start"
 	t.DrawText(screen, msgStart, t.NormalText, g.sWidth/2, g.sHeight/2, theme.TextColor)
 }
 
+// DrawLobby draws the lobby with the games announced on the local network, the selected
+// game is highlighted. It also draws the address typed manually and the available keys.
+func (g *Game) DrawLobby(screen *ebiten.Image) {
+	t.DrawText(screen, "Lobby", t.BigText, 30, 100, theme.TextColor)
+	t.DrawText(screen, "Games on the local network:", t.NormalText, 30, 160, theme.TextColor)
+	if len(g.lobbyGames) == 0 {
+		t.DrawText(screen, "Searching for games...", t.NormalText, 50, 200, theme.TextColor)
+	}
+	for i, game := range g.lobbyGames {
+		color := theme.TextColor
+		if i == g.lobbySelected {
+			color = theme.SelectedTextColor
+		}
+		status := "open"
+		if !game.Open {
+			status = "playing"
+		}
+		msgGame := fmt.Sprintf("%s - %s - %s - %d/2 %s", game.Name, game.Mode, game.Song, game.Players, status)
+		t.DrawText(screen, msgGame, t.NormalText, 50, 200+i*30, color)
+	}
+
+	if g.lobbyTyping {
+		t.DrawText(screen, "Address: "+g.lobbyAddress+"_", t.NormalText, 30, 480, theme.SelectedTextColor)
+		t.DrawText(screen, "ENTER join | ESC cancel", t.NormalText, 30, 510, theme.TextColor)
+	} else {
+		t.DrawText(screen, "ENTER join | S spectate | M enter address", t.NormalText, 30, 480, theme.TextColor)
+		t.DrawText(screen, "H host GoRythm | C host Classic | ESC menu", t.NormalText, 30, 510, theme.TextColor)
+	}
+	if g.onlineError != "" {
+		t.DrawText(screen, g.onlineError, t.NormalText, 30, 550, theme.SelectedTextColor)
+	}
+}
+
+// DrawSettings draws the settings screen with the value of each setting,
+// the selected setting is highlighted.
+func (g *Game) DrawSettings(screen *ebiten.Image) {
+	t.DrawText(screen, "Settings", t.BigText, 30, 100, theme.TextColor)
+	for item := settingsItem(0); item < settingsItemCount; item++ {
+		color := theme.TextColor
+		if item == g.settingsSelected {
+			color = theme.SelectedTextColor
+		}
+		label := settingsLabel(g.settings, item)
+		if item == g.settingsSelected && isTextSetting(item) {
+			label += "_"
+		}
+		t.DrawText(screen, label, t.NormalText, 70, 200+int(item)*40, color)
+	}
+	t.DrawText(screen, "UP/DOWN select | LEFT/RIGHT change | type the texts", t.NormalText, 30, 480, theme.TextColor)
+	t.DrawText(screen, "ENTER/ESC save and return", t.NormalText, 30, 510, theme.TextColor)
+	if g.settingsError != "" {
+		t.DrawText(screen, g.settingsError, t.NormalText, 30, 550, theme.SelectedTextColor)
+	}
+}
+
+// DrawRules draws the rules screen with the state of each variant, the selected variant is
+// highlighted. The variants apply to the local 3x3 modes.
+func (g *Game) DrawRules(screen *ebiten.Image) {
+	t.DrawText(screen, "Rules", t.BigText, 30, 100, theme.TextColor)
+	t.DrawText(screen, "Variants of the PVP, AI and GoRythm modes:", t.NormalText, 30, 160, theme.TextColor)
+	for item := rulesItem(0); item < rulesItemCount; item++ {
+		color := theme.TextColor
+		if item == g.rulesSelected {
+			color = theme.SelectedTextColor
+		}
+		t.DrawText(screen, rulesLabel(g.settings.Rules, item), t.NormalText, 50, 200+int(item)*50, color)
+	}
+	t.DrawText(screen, "UP/DOWN select | LEFT/RIGHT/ENTER change", t.NormalText, 30, 480, theme.TextColor)
+	t.DrawText(screen, "ESC save and return", t.NormalText, 30, 510, theme.TextColor)
+	if g.settingsError != "" {
+		t.DrawText(screen, g.settingsError, t.NormalText, 30, 550, theme.SelectedTextColor)
+	}
+}
+
+// DrawProfiles draws the profiles screen with the list of the profiles and the lifetime
+// statistics of the selected profile.
+func (g *Game) DrawProfiles(screen *ebiten.Image) {
+	t.DrawText(screen, "Profiles", t.BigText, 30, 100, theme.TextColor)
+	store := g.profileStore()
+	names := store.Names()
+	if len(names) == 0 {
+		t.DrawText(screen, "No profile yet, press N to create one", t.NormalText, 30, 150, theme.TextColor)
+	}
+	for i, name := range names {
+		color := theme.TextColor
+		if i == g.profileSelected {
+			color = theme.SelectedTextColor
+		}
+		msgProfile := name
+		if name == store.Player1 {
+			msgProfile += " [player 1]"
+		}
+		if name == store.Player2 {
+			msgProfile += " [player 2]"
+		}
+		t.DrawText(screen, msgProfile, t.NormalText, 30, 150+i*25, color)
+	}
+
+	if profile := g.selectedProfile(); profile != nil {
+		total := profile.Total()
+		lines := []string{fmt.Sprintf("Games: %d | W/L/D: %d/%d/%d", total.Games(), total.Wins, total.Losses, total.Draws)}
+		for _, records := range []map[string]profiles.Record{profile.VsAI, profile.PvP} {
+			opponents := make([]string, 0, len(records))
+			for opponent := range records {
+				opponents = append(opponents, opponent)
+			}
+			sort.Strings(opponents)
+			for _, opponent := range opponents {
+				r := records[opponent]
+				lines = append(lines, fmt.Sprintf("vs %s: %d/%d/%d", opponent, r.Wins, r.Losses, r.Draws))
+			}
+		}
+		lines = append(lines, fmt.Sprintf("Beats hit: %d/%d | Avg offset: %+.0fms", profile.BeatsHit, profile.Hits, profile.AverageOffset()*1000))
+		older, recent := profile.AccuracyTrend()
+		lines = append(lines, fmt.Sprintf("Accuracy trend: %.1f%% -> %.1f%%", older, recent))
+		for i, line := range lines {
+			t.DrawText(screen, line, t.NormalText, 30, 380+i*22, theme.TextColor)
+		}
+	}
+
+	if g.profileTyping {
+		t.DrawText(screen, "Name: "+g.profileInput+"_", t.NormalText, 30, 610, theme.SelectedTextColor)
+	} else {
+		t.DrawText(screen, "1/2 set player | N new | D delete", t.NormalText, 30, 610, theme.TextColor)
+		t.DrawText(screen, "J export JSON | C export CSV | ESC menu", t.NormalText, 30, 635, theme.TextColor)
+	}
+	if g.profileMessage != "" {
+		t.DrawText(screen, g.profileMessage, t.NormalText, 30, 670, theme.SelectedTextColor)
+	}
+}
+
+// DrawSeriesSummary draws the summary of a finished series: the winner, the result of each game
+// and the cumulative points of both players.
+func (g *Game) DrawSeriesSummary(screen *ebiten.Image) {
+	t.DrawText(screen, "Series over", t.BigText, 30, 100, theme.TextColor)
+	if g.series == nil {
+		return
+	}
+	msgWinner := "The series is a draw!"
+	if winner := g.series.Winner(); winner != rules.None {
+		msgWinner = fmt.Sprintf("%s wins the series!", winner)
+	}
+	t.DrawText(screen, msgWinner, t.NormalText, 30, 160, theme.GameOverTextColor)
+	msgFormat := fmt.Sprintf("%s | Wins X %d - %d O | Draws %d", g.series.Config, g.series.Wins(rules.X), g.series.Wins(rules.O), g.series.Draws())
+	t.DrawText(screen, msgFormat, t.NormalText, 30, 190, theme.TextColor)
+
+	// Only the last games fit on the screen
+	first := max(len(g.series.Results)-summaryGames, 0)
+	for i, r := range g.series.Results[first:] {
+		winner := "Draw"
+		if r.Winner != rules.None {
+			winner = string(r.Winner) + " wins"
+		}
+		msgGame := fmt.Sprintf("Game %d (%s starts): %s | X %d - %d O", first+i+1, r.Starter, winner, r.PointsX, r.PointsO)
+		t.DrawText(screen, msgGame, t.NormalText, 30, 230+i*25, theme.TextColor)
+	}
+
+	msgTotal := fmt.Sprintf("Total score: X %d - %d O", g.series.Total(rules.X), g.series.Total(rules.O))
+	t.DrawText(screen, msgTotal, t.NormalText, 30, 560, theme.SelectedTextColor)
+	t.DrawText(screen, "Press ENTER to continue", t.NormalText, 30, 600, theme.TextColor)
+}
+
 // DrawTimer draws the countdown timer before the game starts.
 func (g *Game) DrawTimer(screen *ebiten.Image) {
+	if g.isOnlineMode() && g.onlineStart.IsZero() {
+		msgWaiting := "Waiting for an opponent..."
+		if g.gameMode == SPECTATOR_MODE {
+			msgWaiting = "Waiting for a match..."
+		}
+		textWidth, _ := text.Measure(msgWaiting, t.NormalText, 0)
+		t.DrawText(screen, msgWaiting, t.NormalText, (g.sWidth-int(textWidth))/2, g.sHeight/2, theme.TextColor)
+		return
+	}
 	// Make a countdown timer of 3 seconds
 	if g.countdown > 0 {
 		msgTimer := fmt.Sprintf("%v", g.countdown)
@@ -86,14 +339,20 @@ func (g *Game) DrawGame(screen *ebiten.Image) {
 	if g.boardImage == nil || g.gameImage == nil {
 		log.LogMessage(log.FATAL, "boardImage or gameImage is nil")
 	}
-	screen.DrawImage(g.boardImage, nil)
-	screen.DrawImage(g.gameImage, nil)
+	if g.ultimate != nil {
+		g.DrawUltimate(screen)
+	} else if g.cube != nil {
+		g.DrawCube(screen)
+	} else {
+		screen.DrawImage(g.boardImage, nil)
+		screen.DrawImage(g.gameImage, nil)
+	}
 
-	if g.gameMode == GORYTHM_MODE {
+	if g.isRythmMode() {
 		// Calculate the elapsed time
 		elapsed := time.Since(g.goRythm.startTime).Seconds()
 
-		if g.state != StateGameOver {
+		if g.state == StatePlaying {
 			for _, beat := range g.goRythm.beatMap {
 				if elapsed >= beat.Time && elapsed < beat.Time+0.1 { // Allow a small margin for matching
 					g.goRythm.circleColorChangeTime = time.Now()
@@ -117,6 +376,25 @@ func (g *Game) DrawGame(screen *ebiten.Image) {
 		}
 	}
 
+	// Draw the order the symbols will be removed in, 1 is the next one
+	for _, cells := range g.removalQueues() {
+		for i, cell := range cells {
+			msgOrder := fmt.Sprintf("%d", i+1)
+			t.DrawText(screen, msgOrder, t.NormalText, cell[0]*g.sWidth/3+10, cell[1]*g.sWidth/3+5, theme.SelectedTextColor)
+		}
+	}
+
+	if g.analysis {
+		g.DrawAnalysis(screen)
+	}
+	if g.hintShown() && g.state == StatePlaying {
+		g.DrawHint(screen)
+	}
+	if hints := g.tallyX.Hints + g.tallyO.Hints; hints > 0 {
+		msgHints := fmt.Sprintf("Hints: X %d | O %d (assisted)", g.tallyX.Hints, g.tallyO.Hints)
+		t.DrawText(screen, msgHints, t.NormalText, 10, g.sHeight-250, theme.SelectedTextColor)
+	}
+
 	// Draw rounds
 	msgRounds := fmt.Sprintf("Round: %v", g.rounds)
 	t.DrawText(screen, msgRounds, t.NormalText, 10, g.sHeight-30, theme.TextColor)
@@ -126,33 +404,110 @@ func (g *Game) DrawGame(screen *ebiten.Image) {
 
 	msgPlayer := fmt.Sprintf("Player: %v", g.currentPlayerSymbol)
 	t.DrawText(screen, msgPlayer, t.NormalText, 10, g.sHeight-60, theme.TextColor)
+
+	if g.position != nil && !g.position.Variant.Classic() {
+		t.DrawText(screen, "Rules: "+g.position.Variant.String(), t.NormalText, 10, g.sHeight-90, theme.TextColor)
+		if g.position.Variant.Wild && g.state != StateGameOver {
+			msgWild := fmt.Sprintf("Places: %v (SPACE)", g.pieceSymbol())
+			t.DrawText(screen, msgWild, t.NormalText, g.sWidth-150, g.sHeight-60, theme.SelectedTextColor)
+		}
+	}
+
+	if g.aiThinking() && g.state == StatePlaying {
+		// The dots grow while the AI thinks
+		dots := int(time.Since(g.aiJob.start)/(250*time.Millisecond))%3 + 1
+		msgThinking := "AI thinking" + strings.Repeat(".", dots)
+		t.DrawText(screen, msgThinking, t.NormalText, g.sWidth-150, g.sHeight-120, theme.SelectedTextColor)
+	}
+
+	if g.gameMode == WATCH_MODE {
+		t.DrawText(screen, g.watchLabel(), t.NormalText, g.sWidth-200, g.sHeight-90, theme.TextColor)
+	}
+
+	if g.series != nil {
+		game := g.series.Game()
+		if g.gameRecorded {
+			game = len(g.series.Results)
+		}
+		msgSeries := fmt.Sprintf("%s - Game %d | X %d - %d O", g.series.Config, game, g.series.Wins(rules.X), g.series.Wins(rules.O))
+		t.DrawText(screen, msgSeries, t.NormalText, 10, g.sHeight-205, theme.TextColor)
+	}
+
+	if g.clock != nil {
+		g.DrawClock(screen)
+	}
+
+	if g.online != nil {
+		msgYou := fmt.Sprintf("You: %v", g.online.Symbol())
+		if g.isSpectating() {
+			msgYou = "Spectating"
+		}
+		t.DrawText(screen, msgYou, t.NormalText, 10, g.sHeight-90, theme.TextColor)
+		msgSpectators := fmt.Sprintf("Spectators: %v", g.spectators)
+		t.DrawText(screen, msgSpectators, t.NormalText, g.sWidth-120, g.sHeight-90, theme.TextColor)
+		if g.judgement != "" {
+			t.DrawText(screen, g.judgement, t.NormalText, g.sWidth-120, g.sHeight-60, theme.TextColor)
+		}
+	}
+}
+
+// DrawClock draws the remaining time of both players, the running time is highlighted.
+func (g *Game) DrawClock(screen *ebiten.Image) {
+	now := time.Now()
+	for i, player := range []rules.Symbol{rules.X, rules.O} {
+		color := theme.TextColor
+		if player == g.clock.Running() {
+			color = theme.SelectedTextColor
+		}
+		msgClock := fmt.Sprintf("%s %s", player, clock.Format(g.clock.Remaining(player, now)))
+		t.DrawText(screen, msgClock, t.NormalText, 10+i*(g.sWidth-90), g.sHeight-180, color)
+	}
+}
+
+// DrawPause draws the game paused with the key to resume it.
+func (g *Game) DrawPause(screen *ebiten.Image) {
+	g.DrawGame(screen)
+	msgPause := "Paused"
+	textWidth, _ := text.Measure(msgPause, t.BigText, 0)
+	t.DrawText(screen, msgPause, t.BigText, (g.sWidth-int(textWidth))/2, g.sHeight-130, theme.GameOverTextColor)
+	msgResume := "Press P to resume"
+	textWidth, _ = text.Measure(msgResume, t.NormalText, 0)
+	t.DrawText(screen, msgResume, t.NormalText, (g.sWidth-int(textWidth))/2, g.sHeight-60, theme.TextColor)
 }
 
 // DrawGameOver draws the game over screen with the winner and scores.
 // It also draws the winning line if there is one on the board.
 func (g *Game) DrawGameOver(screen *ebiten.Image) {
 	g.DrawGame(screen)
-	if g.win != NONE_PLAYING || g.gameMode == GORYTHM_MODE {
-		_, winningLine := g.checkWinBoard()
-		if winningLine != nil {
+	if g.win != NONE_PLAYING || g.isRythmMode() {
+		board := g.rulesBoard()
+		if _, winningLine := board.Winner(); winningLine != nil {
 			dc := gg.NewContext(g.sWidth, g.sWidth)
 			dc.SetColor(theme.WinningLineColor)
 			dc.SetLineWidth(10)
-			startX := float64(winningLine[0][1]*160 + 80)
-			startY := float64(winningLine[0][0]*160 + 80)
-			endX := float64(winningLine[2][1]*160 + 80)
-			endY := float64(winningLine[2][0]*160 + 80)
+			startX := float64(winningLine[0][0]*160 + 80)
+			startY := float64(winningLine[0][1]*160 + 80)
+			endX := float64(winningLine[2][0]*160 + 80)
+			endY := float64(winningLine[2][1]*160 + 80)
 			dc.DrawLine(startX, startY, endX, endY)
 			dc.Stroke()
 			screen.DrawImage(ebiten.NewImageFromImage(dc.Image()), nil)
 		}
 	}
 	msgPressEnter := "Press ENTER to play again"
+	if g.series != nil && !g.series.Over() {
+		msgPressEnter = fmt.Sprintf("Press ENTER for game %d", g.series.Game())
+	} else if g.series != nil {
+		msgPressEnter = "Press ENTER for the series summary"
+	}
 	t.DrawText(screen, msgPressEnter, t.NormalText, (g.sWidth-150)/2, g.sHeight-130, theme.TextColor)
+	if g.gameSolver() != nil && len(g.position.History) > 0 {
+		t.DrawText(screen, "Press R to review the moves", t.NormalText, (g.sWidth-150)/2, g.sHeight-220, theme.TextColor)
+	}
 	if g.win != NONE_PLAYING {
 		msgWin := fmt.Sprintf("%v wins!", g.win)
 		t.DrawText(screen, msgWin, t.BigText, (g.sWidth-150)/2, g.sHeight-100, theme.GameOverTextColor)
-	} else if g.gameMode == GORYTHM_MODE {
+	} else if g.isRythmMode() {
 		msgDraw := "Score draw!"
 		t.DrawText(screen, msgDraw, t.BigText, (g.sWidth-150)/2, g.sHeight-100, theme.GameOverTextColor)
 	} else {
@@ -161,4 +516,51 @@ func (g *Game) DrawGameOver(screen *ebiten.Image) {
 	}
 	msgOX := fmt.Sprintf("O Score: %v | X Score: %v", g.pointsO, g.pointsX)
 	t.DrawText(screen, msgOX, t.NormalText, (g.sWidth-150)/2, g.sHeight-30, theme.TextColor)
+	if g.onlineError != "" {
+		t.DrawText(screen, g.onlineError, t.NormalText, (g.sWidth-150)/2, g.sHeight-160, theme.SelectedTextColor)
+	} else if g.timeout != NONE_PLAYING {
+		msgTimeout := fmt.Sprintf("%v lost on time", g.timeout)
+		t.DrawText(screen, msgTimeout, t.NormalText, (g.sWidth-150)/2, g.sHeight-160, theme.SelectedTextColor)
+	}
+	if g.gameRecorded && g.highScoreRank >= 0 {
+		msgRank := fmt.Sprintf("New high score: #%d", g.highScoreRank+1)
+		t.DrawText(screen, msgRank, t.NormalText, (g.sWidth-150)/2, g.sHeight-190, theme.SelectedTextColor)
+	}
+}
+
+// DrawHighScores draws the high-score table of the selected game mode with the personal best
+// of the player. The result of the last game is highlighted.
+func (g *Game) DrawHighScores(screen *ebiten.Image) {
+	difficulty := difficultyName(g.highScoreMode)
+	t.DrawText(screen, "High scores", t.BigText, 30, 100, theme.TextColor)
+	t.DrawText(screen, fmt.Sprintf("%s - %s", rhythm.SongName, difficulty), t.NormalText, 30, 150, theme.TextColor)
+	t.DrawText(screen, "#  Player  Score  Acc.  Combo  P/G/O/M", t.NormalText, 30, 190, theme.TextColor)
+
+	results := g.scores.HighScores(rhythm.SongName, difficulty)
+	if len(results) == 0 {
+		t.DrawText(screen, "No score yet", t.NormalText, 30, 220, theme.TextColor)
+	}
+	for i, r := range results {
+		color := theme.TextColor
+		if i == g.highScoreRank {
+			color = theme.SelectedTextColor
+		}
+		player := r.Player
+		if r.Assisted() {
+			player += "*"
+		}
+		msgResult := fmt.Sprintf("%d. %s  %d  %.1f%%  %d  %d/%d/%d/%d  %s", i+1, player, r.Score, r.Accuracy,
+			r.MaxCombo, r.Perfect, r.Good, r.Ok, r.Miss, r.Date.Format("2006-01-02"))
+		t.DrawText(screen, msgResult, t.NormalText, 30, 220+i*25, color)
+	}
+
+	if best, ok := g.scores.PersonalBest(rhythm.SongName, difficulty, g.settings.PlayerName); ok {
+		msgBest := fmt.Sprintf("Personal best: %d (%.1f%%)", best.Score, best.Accuracy)
+		t.DrawText(screen, msgBest, t.NormalText, 30, 500, theme.TextColor)
+	}
+	if g.highScoreMessage != "" {
+		t.DrawText(screen, g.highScoreMessage, t.NormalText, 30, 530, theme.SelectedTextColor)
+	}
+	t.DrawText(screen, "* assisted by hints", t.NormalText, 30, 570, theme.TextColor)
+	t.DrawText(screen, "LEFT/RIGHT difficulty | ENTER/ESC menu", t.NormalText, 30, 600, theme.TextColor)
 }
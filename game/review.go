@@ -0,0 +1,139 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/log"
+	"GoRythm/internal/rules"
+	"GoRythm/internal/solver"
+	t "GoRythm/internal/text"
+	"GoRythm/internal/theme"
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const (
+	reviewCell = 80 // The size of a cell of the board of the review screen
+	reviewX    = 30 // The left of the board of the review screen
+	reviewY    = 130
+)
+
+// startReview compares each move of the finished 3x3 game with the best move of its position
+// and opens the review screen on the first move the player to review did not play best, or on the
+// first move. It returns false if the game cannot be reviewed.
+func (g *Game) startReview() bool {
+	s := g.gameSolver()
+	if s == nil || len(g.position.History) == 0 {
+		return false
+	}
+	// The turn switches after each move
+	first := g.position.Turn
+	if len(g.position.History)%2 == 1 {
+		first = first.Opponent()
+	}
+	review, err := s.Review(first, g.position.History)
+	if err != nil {
+		log.LogMessage(log.ERROR, "failed to review the game: "+err.Error())
+		return false
+	}
+	g.review = review
+	g.reviewMove = 0
+	for i, a := range review {
+		if a.Quality != solver.Best && g.reviewed(a.Player) {
+			g.reviewMove = i
+			break
+		}
+	}
+	g.state = StateReview
+	return true
+}
+
+// reviewed returns true if the moves of the player are looked at first: the moves of the humans
+// against the AI, the moves of both players otherwise.
+func (g *Game) reviewed(player rules.Symbol) bool {
+	return !g.hasAI() || SymbolPlaying(player) == g.humanSymbol
+}
+
+// handleStateReview handles the review inputs, LEFT/RIGHT go through the moves and ESC or ENTER
+// returns to the end of the game.
+func (g *Game) handleStateReview() {
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyLeft):
+		g.reviewMove = max(g.reviewMove-1, 0)
+	case inpututil.IsKeyJustPressed(ebiten.KeyRight):
+		g.reviewMove = min(g.reviewMove+1, len(g.review)-1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyEscape), inpututil.IsKeyJustPressed(ebiten.KeyEnter):
+		g.state = StateGameOver
+	}
+}
+
+// reviewBoard returns the board after the selected move of the review.
+func (g *Game) reviewBoard() rules.Board {
+	var b rules.Board
+	for _, a := range g.review[:g.reviewMove+1] {
+		b[a.Move.X][a.Move.Y] = a.Move.Symbol
+	}
+	return b
+}
+
+// qualityColor returns the color of the quality of a move: the text color for the best moves,
+// the color of a loss for the moves changing the outcome.
+func qualityColor(q solver.Quality) color.Color {
+	switch q {
+	case solver.Inaccuracy:
+		return theme.SelectedTextColor
+	case solver.Mistake, solver.Blunder:
+		return theme.LossValueColor
+	}
+	return theme.TextColor
+}
+
+// DrawReview draws the review screen: the board after the selected move, with the move played
+// and the best move of its position, the comment of the move and the mistakes of each player.
+func (g *Game) DrawReview(screen *ebiten.Image) {
+	t.DrawText(screen, "Review", t.BigText, 30, 100, theme.TextColor)
+	if len(g.review) == 0 {
+		return
+	}
+	a := g.review[g.reviewMove]
+
+	side := float32(3 * reviewCell)
+	for i := 0; i <= 3; i++ {
+		position := float32(i * reviewCell)
+		vector.StrokeLine(screen, reviewX+position, reviewY, reviewX+position, reviewY+side, ultimateThinLine, theme.BoardColor, false)
+		vector.StrokeLine(screen, reviewX, reviewY+position, reviewX+side, reviewY+position, ultimateThinLine, theme.BoardColor, false)
+	}
+	if a.Quality != solver.Best {
+		bx, by := float32(reviewX+a.Best.Move.X*reviewCell), float32(reviewY+a.Best.Move.Y*reviewCell)
+		vector.StrokeRect(screen, bx, by, reviewCell, reviewCell, 4, theme.WinValueColor, false)
+		drawSymbol(screen, a.Best.Move.Symbol, bx, by, reviewCell, 2, theme.WinValueColor)
+	}
+	b := g.reviewBoard()
+	for x := 0; x < 3; x++ {
+		for y := 0; y < 3; y++ {
+			c := symbolColor(b[x][y])
+			if x == a.Move.X && y == a.Move.Y {
+				c = qualityColor(a.Quality)
+			}
+			drawSymbol(screen, b[x][y], float32(reviewX+x*reviewCell), float32(reviewY+y*reviewCell), reviewCell, 3, c)
+		}
+	}
+
+	msgMove := fmt.Sprintf("Move %d of %d: %s at (%d, %d)", g.reviewMove+1, len(g.review), a.Player, a.Move.X, a.Move.Y)
+	t.DrawText(screen, msgMove, t.NormalText, 30, 410, theme.TextColor)
+	msgValue := fmt.Sprintf("%s: %s", a.Quality, a.Played)
+	t.DrawText(screen, msgValue, t.NormalText, 30, 440, qualityColor(a.Quality))
+	t.DrawText(screen, a.Comment(), t.NormalText, 30, 470, qualityColor(a.Quality))
+
+	for i, player := range []rules.Symbol{rules.X, rules.O} {
+		msgCount := fmt.Sprintf("%s: %d inaccuracies, %d mistakes, %d blunders", player,
+			solver.Count(g.review, player, solver.Inaccuracy), solver.Count(g.review, player, solver.Mistake), solver.Count(g.review, player, solver.Blunder))
+		t.DrawText(screen, msgCount, t.NormalText, 30, 520+i*30, theme.TextColor)
+	}
+	t.DrawText(screen, "LEFT/RIGHT moves | ESC return", t.NormalText, 30, 610, theme.TextColor)
+}
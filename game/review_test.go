@@ -0,0 +1,43 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/rules"
+	"GoRythm/internal/solver"
+	"testing"
+)
+
+// TestGame_startReview tests the startReview function with a game lost against the AI.
+// Checks if the review opens on the blunder of the human and shows the board after it.
+func TestGame_startReview(t *testing.T) {
+	g := NewGame()
+	g.gameMode = HARD_AI_MODE
+	g.humanSymbol = O_PLAYING
+	g.currentPlayerSymbol = X_PLAYING
+	g.startPosition()
+	if g.startReview() {
+		t.Fatal("Expected no review before the first move")
+	}
+	// O answers the center with an edge instead of a corner
+	for _, m := range []rules.Move{{X: 1, Y: 1, Symbol: rules.X}, {X: 1, Y: 0, Symbol: rules.O}, {X: 0, Y: 0, Symbol: rules.X}} {
+		g.position.Play(m)
+	}
+	if !g.startReview() || g.state != StateReview {
+		t.Fatalf("Expected the review screen, got state %v", g.state)
+	}
+	if g.reviewMove != 1 || g.review[1].Quality != solver.Blunder {
+		t.Errorf("Expected the blunder of O to be selected, got move %d", g.reviewMove+1)
+	}
+	if b := g.reviewBoard(); b[1][0] != rules.O || b[0][0] != rules.None {
+		t.Errorf("Expected the board after the blunder, got %v", b)
+	}
+
+	g.settings.Rules = rules.Variant{MaxSymbols: 3}
+	g.startPosition()
+	g.position.Play(rules.Move{X: 1, Y: 1, Symbol: rules.X})
+	if g.startReview() {
+		t.Error("Expected no review with a symbol limit")
+	}
+}
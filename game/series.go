@@ -0,0 +1,68 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/rules"
+	"GoRythm/internal/series"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// seriesConfig returns the series format selected in the menu.
+func (g *Game) seriesConfig() series.Config {
+	return series.Presets[g.seriesPreset]
+}
+
+// nextSeriesPreset selects the next series format of the menu.
+func (g *Game) nextSeriesPreset() {
+	g.seriesPreset = (g.seriesPreset + 1) % len(series.Presets)
+}
+
+// startSeries starts a series of local games with the selected format, the first game
+// is started by the current player. A single game has no series.
+func (g *Game) startSeries() {
+	g.series = nil
+	if config := g.seriesConfig(); config.Format != series.Single && !g.isOnlineMode() {
+		g.series = series.New(config, rules.Symbol(g.currentPlayerSymbol))
+	}
+}
+
+// startingPlayerType returns the type of the player starting the game: the AI when it plays
+// the current symbol against a human, else a human.
+func (g *Game) startingPlayerType() PlayerType {
+	if g.hasAI() && g.currentPlayerSymbol != g.humanSymbol {
+		return AI_TYPE
+	}
+	return HUMAN_TYPE
+}
+
+// nextSeriesGame starts the next game of the series in the same mode, keeping the series
+// results. The starting player alternates and the human keeps its symbol against the AI.
+func (g *Game) nextSeriesGame() error {
+	mode := g.gameMode
+	g.gameImage.Clear()
+	g.restartGame()
+	g.gameMode = mode
+	g.currentPlayerSymbol = SymbolPlaying(g.series.Starter())
+	g.currentPlayerType = g.startingPlayerType()
+	if err := g.audioPlayer.Restart(); err != nil {
+		return err
+	}
+	g.state = StateLoading
+	g.countdownTime = time.Now()
+	g.startModeBoard()
+	return nil
+}
+
+// handleStateSeriesSummary handles the series summary screen shown at the end of a series.
+// Enter shows the high scores of the last game.
+func (g *Game) handleStateSeriesSummary() {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.series = nil
+		g.openHighScores(g.highScoreMode)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/rules"
+	"GoRythm/internal/series"
+	"GoRythm/internal/storage"
+	"testing"
+)
+
+// TestGame_nextSeriesGame tests the nextSeriesGame function in a best of 3 series against the AI.
+// Checks if the results are kept, the starting player alternates and the human keeps its symbol.
+func TestGame_nextSeriesGame(t *testing.T) {
+	storage.SetDir(t.TempDir())
+	defer storage.SetDir("")
+
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.gameMode = EASY_AI_MODE
+	g.seriesPreset = 1
+	g.currentPlayerSymbol = X_PLAYING
+	g.humanSymbol = X_PLAYING
+	g.startSeries()
+	if g.series == nil || g.series.Config != (series.Config{Format: series.BestOf, Target: 3}) {
+		t.Fatalf("Expected a best of 3 series, got %v", g.series)
+	}
+
+	g.win = X_PLAYING
+	g.pointsX = 1
+	g.recordGame()
+	if err := g.nextSeriesGame(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if g.state != StateLoading || g.gameMode != EASY_AI_MODE || g.pointsX != 0 {
+		t.Errorf("Expected a new game in the same mode, got state %v and mode %v", g.state, g.gameMode)
+	}
+	if g.currentPlayerSymbol != O_PLAYING || g.currentPlayerType != AI_TYPE {
+		t.Errorf("Expected the AI to start with O, got %s %s", g.currentPlayerSymbol, g.currentPlayerType)
+	}
+	if g.series.Wins(rules.X) != 1 || g.series.Game() != 2 {
+		t.Errorf("Expected 1 win of X before game 2, got %d before game %d", g.series.Wins(rules.X), g.series.Game())
+	}
+}
+
+// TestGame_startSeries tests the startSeries function with a single game.
+// Checks if no series is started.
+func TestGame_startSeries(t *testing.T) {
+	g := NewGame()
+	g.gameMode = CLASSIC_PVP_MODE
+	g.startSeries()
+	if g.series != nil {
+		t.Error("Expected no series for a single game")
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/log"
+	"GoRythm/internal/settings"
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// A settingsItem type represent the settings that can be changed in the settings screen.
+type settingsItem int
+
+const (
+	VOLUME_ITEM settingsItem = iota
+	LOG_LEVEL_ITEM
+	WINDOW_SCALE_ITEM
+	COUNTDOWN_ITEM
+	PLAYER_NAME_ITEM
+	SERVER_ADDRESS_ITEM
+	settingsItemCount // The number of settings items
+)
+
+const (
+	volumeStep      = 0.05 // The volume change of one key press
+	windowScaleStep = 0.25 // The window scale change of one key press
+)
+
+// The log levels that can be selected in the settings screen
+var settingsLogLevels = []int{log.DEBUG, log.INFO, log.WARN, log.ERROR}
+
+// Settings returns the current settings of the game.
+func (g *Game) Settings() settings.Settings {
+	return g.settings
+}
+
+// ApplySettings changes the settings of the game and applies them immediately: volume of
+// the music, log level, window size, countdown and online options.
+func (g *Game) ApplySettings(s settings.Settings) {
+	s = s.Normalize()
+	g.settings = s
+	log.SetLevel(s.Level())
+	if g.audioPlayer != nil {
+		g.audioPlayer.SetVolume(s.Volume)
+	}
+	if g.sWidth > 0 && g.sHeight > 0 {
+		ebiten.SetWindowSize(int(float64(g.sWidth)*s.WindowScale), int(float64(g.sHeight)*s.WindowScale))
+	}
+	if g.state != StateLoading {
+		g.countdown = s.Countdown
+	}
+	if s.ServerAddress != "" {
+		g.serverAddress = s.ServerAddress
+	}
+}
+
+// openSettings opens the settings screen.
+func (g *Game) openSettings() {
+	g.state = StateSettings
+	g.settingsSelected = VOLUME_ITEM
+	g.settingsError = ""
+}
+
+// handleStateSettings handles the settings screen inputs. A setting is selected with the up
+// and down arrows and changed with the left and right arrows, the change is applied immediately.
+// The player name and the server address are typed when selected. Enter or Escape saves the
+// settings and returns to the menu.
+func (g *Game) handleStateSettings() {
+	typed := string(ebiten.AppendInputChars(nil))
+	g.settings = editSetting(g.settings, g.settingsSelected, typed, inpututil.IsKeyJustPressed(ebiten.KeyBackspace))
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyUp):
+		g.settingsSelected = (g.settingsSelected + settingsItemCount - 1) % settingsItemCount
+	case inpututil.IsKeyJustPressed(ebiten.KeyDown):
+		g.settingsSelected = (g.settingsSelected + 1) % settingsItemCount
+	case inpututil.IsKeyJustPressed(ebiten.KeyLeft):
+		g.ApplySettings(changeSetting(g.settings, g.settingsSelected, -1))
+	case inpututil.IsKeyJustPressed(ebiten.KeyRight):
+		g.ApplySettings(changeSetting(g.settings, g.settingsSelected, 1))
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnter), inpututil.IsKeyJustPressed(ebiten.KeyEscape):
+		// The typed texts are normalized once complete, an emptied name is only replaced now
+		g.ApplySettings(g.settings)
+		if err := g.settings.Save(); err != nil {
+			log.LogMessage(log.ERROR, "failed to save the settings: "+err.Error())
+			g.settingsError = err.Error()
+			return
+		}
+		g.state = StateMenu
+	}
+}
+
+// changeSetting returns the settings with the given item increased (direction 1)
+// or decreased (direction -1) by one step.
+func changeSetting(s settings.Settings, item settingsItem, direction int) settings.Settings {
+	switch item {
+	case VOLUME_ITEM:
+		s.Volume = float64(int(s.Volume/volumeStep+0.5)+direction) * volumeStep
+	case LOG_LEVEL_ITEM:
+		i := 0
+		for j, level := range settingsLogLevels {
+			if level == s.Level() {
+				i = j
+			}
+		}
+		i = (i + direction + len(settingsLogLevels)) % len(settingsLogLevels)
+		s.LogLevel = log.LevelName(settingsLogLevels[i])
+	case WINDOW_SCALE_ITEM:
+		s.WindowScale += float64(direction) * windowScaleStep
+	case COUNTDOWN_ITEM:
+		s.Countdown += direction
+	}
+	return s.Normalize()
+}
+
+// editSetting returns the settings with the typed characters added to the text of the given
+// item, and its last character removed with backspace. The other items are not changed.
+func editSetting(s settings.Settings, item settingsItem, typed string, backspace bool) settings.Settings {
+	var text *string
+	switch item {
+	case PLAYER_NAME_ITEM:
+		text = &s.PlayerName
+	case SERVER_ADDRESS_ITEM:
+		text = &s.ServerAddress
+	default:
+		return s
+	}
+	*text += typed
+	if runes := []rune(*text); backspace && len(runes) > 0 {
+		*text = string(runes[:len(runes)-1])
+	}
+	return s
+}
+
+// isTextSetting returns true if the settings item is typed instead of changed by steps.
+func isTextSetting(item settingsItem) bool {
+	return item == PLAYER_NAME_ITEM || item == SERVER_ADDRESS_ITEM
+}
+
+// settingsLabel returns the label of a settings item with its current value.
+func settingsLabel(s settings.Settings, item settingsItem) string {
+	switch item {
+	case VOLUME_ITEM:
+		return fmt.Sprintf("Volume: %d%%", int(s.Volume*100+0.5))
+	case LOG_LEVEL_ITEM:
+		return "Log level: " + s.LogLevel
+	case WINDOW_SCALE_ITEM:
+		return fmt.Sprintf("Window size: x%.2f", s.WindowScale)
+	case COUNTDOWN_ITEM:
+		return fmt.Sprintf("Countdown: %ds", s.Countdown)
+	case PLAYER_NAME_ITEM:
+		return "Player name: " + s.PlayerName
+	case SERVER_ADDRESS_ITEM:
+		return "Server address: " + s.ServerAddress
+	}
+	return ""
+}
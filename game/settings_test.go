@@ -0,0 +1,74 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/log"
+	"GoRythm/internal/settings"
+	"testing"
+)
+
+// TestGame_ApplySettings tests the ApplySettings function.
+// Checks if the volume, log level, countdown and server address are applied immediately.
+func TestGame_ApplySettings(t *testing.T) {
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer log.SetLevel(log.CURRENT)
+
+	s := settings.Default()
+	s.Volume = 0.5
+	s.LogLevel = "warn"
+	s.Countdown = 5
+	s.ServerAddress = "example.com:4242"
+	g.ApplySettings(s)
+	if g.audioPlayer.Volume() != 0.5 {
+		t.Errorf("Expected volume 0.5, got %v", g.audioPlayer.Volume())
+	}
+	if log.Level() != log.WARN {
+		t.Errorf("Expected the warn level, got %d", log.Level())
+	}
+	if g.countdown != 5 || g.serverAddress != "example.com:4242" {
+		t.Errorf("Expected the countdown and address to be applied, got %d and %s", g.countdown, g.serverAddress)
+	}
+}
+
+// TestChangeSetting tests the changeSetting function.
+// Checks if each item is changed by one step and kept in range.
+func TestChangeSetting(t *testing.T) {
+	s := settings.Default()
+	if v := changeSetting(s, VOLUME_ITEM, 1).Volume; v < 0.099 || v > 0.101 {
+		t.Errorf("Expected volume 0.1, got %v", v)
+	}
+	if level := changeSetting(s, LOG_LEVEL_ITEM, -1).Level(); level != log.DEBUG {
+		t.Errorf("Expected the debug level, got %d", level)
+	}
+	if scale := changeSetting(s, WINDOW_SCALE_ITEM, 1).WindowScale; scale != 1.25 {
+		t.Errorf("Expected window scale 1.25, got %v", scale)
+	}
+	s.Countdown = settings.MaxCountdown
+	if countdown := changeSetting(s, COUNTDOWN_ITEM, 1).Countdown; countdown != settings.MaxCountdown {
+		t.Errorf("Expected the countdown to stay at %d, got %d", settings.MaxCountdown, countdown)
+	}
+}
+
+// TestEditSetting tests the editSetting function.
+// Checks if the name and the address are typed and erased, and the other items unchanged.
+func TestEditSetting(t *testing.T) {
+	s := settings.Default()
+	s.PlayerName = "Al"
+	if name := editSetting(s, PLAYER_NAME_ITEM, "ice", false).PlayerName; name != "Alice" {
+		t.Errorf("Expected the name Alice, got %s", name)
+	}
+	if name := editSetting(s, PLAYER_NAME_ITEM, "", true).PlayerName; name != "A" {
+		t.Errorf("Expected the name A, got %s", name)
+	}
+	if addr := editSetting(s, SERVER_ADDRESS_ITEM, "host:4242", false).ServerAddress; addr != "host:4242" {
+		t.Errorf("Expected the address host:4242, got %s", addr)
+	}
+	if edited := editSetting(s, VOLUME_ITEM, "5", true); edited != s {
+		t.Errorf("Expected the volume item not to be typed, got %+v", edited)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/log"
+	"GoRythm/internal/rules"
+	"GoRythm/internal/theme"
+	"GoRythm/internal/ultimate"
+	"image/color"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const (
+	ultimateSymbolMargin = 0.2 // The margin around a symbol, as a fraction of its square
+	ultimateThinLine     = 1   // The width of the lines between the cells, in pixels
+	ultimateThickLine    = 5   // The width of the lines between the sub-boards, in pixels
+)
+
+// The arrow keys moving the cursor on the Ultimate board
+var ultimateArrows = map[ebiten.Key][2]int{
+	ebiten.KeyLeft:  {-1, 0},
+	ebiten.KeyRight: {1, 0},
+	ebiten.KeyUp:    {0, -1},
+	ebiten.KeyDown:  {0, 1},
+}
+
+// startUltimate starts an Ultimate game with the current player, the cursor is at the center.
+func (g *Game) startUltimate() {
+	g.ultimate = ultimate.New(rules.Symbol(g.currentPlayerSymbol))
+	g.ultimateCursor = [2]int{ultimate.Size / 2, ultimate.Size / 2}
+}
+
+// handleUltimatePlaying handles the inputs of an Ultimate game. The AI thinks of its move, a human
+// moves the cursor with the arrows and plays with Enter or Space, plays a cell of the selected
+// sub-board with the board keys, or clicks on a cell.
+func (g *Game) handleUltimatePlaying() {
+	if g.currentPlayerType == AI_TYPE {
+		g.updateAI(g.aiStrategy(), aiMinThink)
+		return
+	}
+	for key, direction := range ultimateArrows {
+		if inpututil.IsKeyJustPressed(key) {
+			g.ultimateCursor[0] = min(max(g.ultimateCursor[0]+direction[0], 0), ultimate.Size-1)
+			g.ultimateCursor[1] = min(max(g.ultimateCursor[1]+direction[1], 0), ultimate.Size-1)
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+		g.playUltimate(ultimate.Move{X: g.ultimateCursor[0], Y: g.ultimateCursor[1]})
+		return
+	}
+	for key, pos := range keyboardToBoard {
+		if inpututil.IsKeyJustPressed(key) {
+			bx, by := g.ultimateCursor[0]/3, g.ultimateCursor[1]/3
+			g.playUltimate(ultimate.Move{X: bx*3 + pos[0], Y: by*3 + pos[1]})
+			return
+		}
+	}
+	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		x, y := ebiten.CursorPosition()
+		if x >= 0 && x < g.sWidth && y >= 0 && y < g.sWidth {
+			g.playUltimate(ultimate.Move{X: x * ultimate.Size / g.sWidth, Y: y * ultimate.Size / g.sWidth})
+		}
+	}
+}
+
+// playUltimate plays the move for the current player if it is legal, switches the player and
+// its clock, and moves the cursor to the sub-board the opponent is sent to. It ends the game
+// when the meta-board is won or when no sub-board is open anymore.
+func (g *Game) playUltimate(m ultimate.Move) {
+	if err := g.ultimate.Play(m); err != nil {
+		log.LogMessage(log.DEBUG, "ultimate move refused: "+err.Error())
+		return
+	}
+	if g.clock != nil {
+		g.clock.Switch(time.Now())
+	}
+	g.switchPlayer()
+	g.rounds++
+	if next := g.ultimate.Next; next[0] >= 0 {
+		g.ultimateCursor = [2]int{next[0]*3 + g.ultimateCursor[0]%3, next[1]*3 + g.ultimateCursor[1]%3}
+	}
+
+	winner, _ := g.ultimate.Winner()
+	g.win = SymbolPlaying(winner)
+	switch g.win {
+	case X_PLAYING:
+		g.pointsX += scorePerWin
+	case O_PLAYING:
+		g.pointsO += scorePerWin
+	}
+	if g.ultimate.Over() {
+		g.state = StateGameOver
+	}
+}
+
+// DrawUltimate draws the Ultimate board: the active sub-boards, the cursor, the grid, the
+// symbols, the won sub-boards and the winning line of the meta-board.
+func (g *Game) DrawUltimate(screen *ebiten.Image) {
+	cell := float32(g.sWidth) / ultimate.Size
+	board := cell * 3
+
+	for bx := 0; bx < 3; bx++ {
+		for by := 0; by < 3; by++ {
+			if g.ultimate.Active(bx, by) {
+				vector.DrawFilledRect(screen, float32(bx)*board, float32(by)*board, board, board, theme.ActiveBoardColor, false)
+			}
+		}
+	}
+	if g.state == StatePlaying && g.currentPlayerType == HUMAN_TYPE {
+		vector.StrokeRect(screen, float32(g.ultimateCursor[0])*cell, float32(g.ultimateCursor[1])*cell, cell, cell, 3, theme.SelectedTextColor, false)
+	}
+
+	for i := 1; i < ultimate.Size; i++ {
+		width := float32(ultimateThinLine)
+		if i%3 == 0 {
+			width = ultimateThickLine
+		}
+		position := float32(i) * cell
+		vector.StrokeLine(screen, position, 0, position, float32(g.sWidth), width, theme.BoardColor, false)
+		vector.StrokeLine(screen, 0, position, float32(g.sWidth), position, width, theme.BoardColor, false)
+	}
+
+	for x := 0; x < ultimate.Size; x++ {
+		for y := 0; y < ultimate.Size; y++ {
+			drawSymbol(screen, g.ultimate.Cell(x, y), float32(x)*cell, float32(y)*cell, cell, 2, symbolColor(g.ultimate.Cell(x, y)))
+		}
+	}
+	for bx := 0; bx < 3; bx++ {
+		for by := 0; by < 3; by++ {
+			drawSymbol(screen, g.ultimate.Meta[bx][by], float32(bx)*board, float32(by)*board, board, 8, theme.WinningLineColor)
+		}
+	}
+
+	if _, line := g.ultimate.Winner(); line != nil {
+		start, end := line[0], line[len(line)-1]
+		vector.StrokeLine(screen, (float32(start[0])+0.5)*board, (float32(start[1])+0.5)*board,
+			(float32(end[0])+0.5)*board, (float32(end[1])+0.5)*board, 10, theme.WinningLineColor, false)
+	}
+}
+
+// symbolColor returns the color of the symbols of a player.
+func symbolColor(symbol rules.Symbol) color.Color {
+	if symbol == rules.O {
+		return theme.SymbolOColor
+	}
+	return theme.SymbolXColor
+}
+
+// drawSymbol draws the symbol in the square of the given position and size: a cross for X
+// and a circle for O. Nothing is drawn for an empty cell.
+func drawSymbol(screen *ebiten.Image, symbol rules.Symbol, x, y, size, width float32, clr color.Color) {
+	margin := size * ultimateSymbolMargin
+	switch symbol {
+	case rules.X:
+		vector.StrokeLine(screen, x+margin, y+margin, x+size-margin, y+size-margin, width, clr, true)
+		vector.StrokeLine(screen, x+size-margin, y+margin, x+margin, y+size-margin, width, clr, true)
+	case rules.O:
+		vector.StrokeCircle(screen, x+size/2, y+size/2, size/2-margin, width, clr, true)
+	}
+}
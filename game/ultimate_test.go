@@ -0,0 +1,67 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/rules"
+	"GoRythm/internal/theme"
+	"GoRythm/internal/ultimate"
+	"testing"
+)
+
+// TestGame_playUltimate tests the playUltimate function against the AI.
+// Checks if the move is played, the AI gets the turn and the cursor moves to the next sub-board.
+func TestGame_playUltimate(t *testing.T) {
+	g := NewGame()
+	g.gameMode = ULTIMATE_MODE
+	g.aiOpponent = true
+	g.state = StatePlaying
+	g.currentPlayerSymbol = X_PLAYING
+	g.currentPlayerType = HUMAN_TYPE
+	g.startUltimate()
+
+	g.playUltimate(ultimate.Move{X: 4, Y: 2})
+	if g.ultimate.Cell(4, 2) != rules.X || g.rounds != 1 {
+		t.Fatalf("Expected X at (4, 2) after one round, got %q after %d rounds", g.ultimate.Cell(4, 2), g.rounds)
+	}
+	if g.currentPlayerSymbol != O_PLAYING || g.currentPlayerType != AI_TYPE {
+		t.Errorf("Expected the AI to play O, got %s %s", g.currentPlayerSymbol, g.currentPlayerType)
+	}
+	if bx, by := g.ultimateCursor[0]/3, g.ultimateCursor[1]/3; bx != 1 || by != 2 {
+		t.Errorf("Expected the cursor in the sub-board (1, 2), got (%d, %d)", bx, by)
+	}
+
+	g.playUltimate(ultimate.Move{X: 0, Y: 0})
+	if g.rounds != 1 {
+		t.Errorf("Expected the illegal move to be refused, got %d rounds", g.rounds)
+	}
+}
+
+// TestGame_playUltimate_win tests the playUltimate function with a winning move.
+// Checks if the game is over and the winner scores.
+func TestGame_playUltimate_win(t *testing.T) {
+	g := NewGame()
+	g.gameMode = ULTIMATE_MODE
+	g.state = StatePlaying
+	g.currentPlayerSymbol = O_PLAYING
+	g.startUltimate()
+	g.ultimate.Meta[0][0], g.ultimate.Meta[1][1] = rules.O, rules.O
+	g.ultimate.Boards[2][2] = rules.Board{{rules.O, rules.O, rules.None}}
+
+	g.playUltimate(ultimate.Move{X: 6, Y: 8})
+	if g.state != StateGameOver || g.win != O_PLAYING || g.pointsO != scorePerWin {
+		t.Errorf("Expected O to win, got state %v, winner %q and %d points", g.state, g.win, g.pointsO)
+	}
+}
+
+// TestSymbolColor tests the symbolColor function.
+// Checks if the X and O symbols are drawn with the colors of their player.
+func TestSymbolColor(t *testing.T) {
+	if c := symbolColor(rules.X); c != theme.SymbolXColor {
+		t.Errorf("Expected the color of X, got %v", c)
+	}
+	if c := symbolColor(rules.O); c != theme.SymbolOColor {
+		t.Errorf("Expected the color of O, got %v", c)
+	}
+}
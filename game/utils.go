@@ -8,6 +8,7 @@ import (
 	"time"
 
 	board "GoRythm/internal/generation"
+	"GoRythm/internal/rules"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -18,10 +19,10 @@ func newRandom() *rand.Rand {
 	return rand.New(s1)
 }
 
-// placeSymbol places the current player symbol on the board at the given position.
-// It also calls the draw function to display the symbol on the screen.
+// placeSymbol places the symbol of the current player on the board at the given position,
+// or the symbol chosen by the variant. It also calls the draw function to display the symbol on the screen.
 func (g *Game) placeSymbol(x int, y int) {
-	switch g.currentPlayerSymbol {
+	switch g.pieceSymbol() {
 	case O_PLAYING:
 		g.board[x][y] = O_PLAYING
 		options := &ebiten.DrawImageOptions{}
@@ -47,7 +48,7 @@ func (g *Game) removeSymbol(x, y int) {
 // highlightSymbol highlights the symbol on the board at the given position.
 // It also calls the draw function to display the highlighted symbol on the screen.
 func (g *Game) highlightSymbol(x, y int) {
-	switch g.currentPlayerSymbol {
+	switch g.board[x][y] {
 	case O_PLAYING:
 		options := &ebiten.DrawImageOptions{}
 		options.GeoM.Translate(float64(x*board.CellSize), float64(y*board.CellSize))
@@ -66,7 +67,7 @@ func (g *Game) switchPlayer() {
 	} else {
 		g.currentPlayerSymbol = X_PLAYING
 	}
-	if g.gameMode != GORYTHM_MODE && g.gameMode != CLASSIC_PVP_MODE {
+	if g.hasAI() {
 		if g.currentPlayerType == HUMAN_TYPE {
 			g.currentPlayerType = AI_TYPE
 		} else {
@@ -75,28 +76,49 @@ func (g *Game) switchPlayer() {
 	}
 }
 
-// checkWinBoard checks the winner based on the board and returns the winner and the winning positions.
-func (g *Game) checkWinBoard() (winner SymbolPlaying, position [][]int) {
-	// Check rows
-	for i := 0; i < 3; i++ {
-		if g.board[i][0] == g.board[i][1] && g.board[i][1] == g.board[i][2] && g.board[i][0] != NONE_PLAYING {
-			return g.board[i][0], [][]int{{0, i}, {1, i}, {2, i}}
-		}
+// hasAI returns whether a human plays against the AI in the game mode.
+func (g *Game) hasAI() bool {
+	switch g.gameMode {
+	case EASY_AI_MODE, MEDIUM_AI_MODE, HARD_AI_MODE:
+		return true
+	case ULTIMATE_MODE, CUBE_MODE:
+		return g.aiOpponent
 	}
-	// Check columns
-	for i := 0; i < 3; i++ {
-		if g.board[0][i] == g.board[1][i] && g.board[1][i] == g.board[2][i] && g.board[0][i] != NONE_PLAYING {
-			return g.board[0][i], [][]int{{i, 0}, {i, 1}, {i, 2}}
-		}
+	return false
+}
+
+// aiName returns the name of the AI opponent of the game mode, as recorded in the profiles.
+func (g *Game) aiName() string {
+	switch g.gameMode {
+	case ULTIMATE_MODE:
+		return "ultimate"
+	case CUBE_MODE:
+		return "3d"
 	}
-	// Check diagonals
-	if g.board[0][0] == g.board[1][1] && g.board[1][1] == g.board[2][2] && g.board[0][0] != NONE_PLAYING {
-		return g.board[0][0], [][]int{{0, 0}, {1, 1}, {2, 2}}
+	return difficultyName(g.gameMode)
+}
+
+// rulesBoard returns a copy of the game board with the symbols of the rules.
+func (g *Game) rulesBoard() rules.Board {
+	var b rules.Board
+	for x := 0; x < 3; x++ {
+		for y := 0; y < 3; y++ {
+			b[x][y] = rules.Symbol(g.board[x][y])
+		}
 	}
-	if g.board[0][2] == g.board[1][1] && g.board[1][1] == g.board[2][0] && g.board[0][2] != NONE_PLAYING {
-		return g.board[0][2], [][]int{{0, 2}, {1, 1}, {2, 0}}
+	return b
+}
+
+// boardWinner returns the winner of the board, which is not the player who aligned the
+// symbols in the misère and Notakto variants.
+func (g *Game) boardWinner() SymbolPlaying {
+	if g.position != nil {
+		winner, _ := g.position.Winner()
+		return SymbolPlaying(winner)
 	}
-	return NONE_PLAYING, nil
+	b := g.rulesBoard()
+	winner, _ := b.Winner()
+	return SymbolPlaying(winner)
 }
 
 // checkWinScore checks the winner based on the score and returns the winner
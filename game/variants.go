@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/log"
+	"GoRythm/internal/rules"
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// A rulesItem type represent the rule variants that can be changed in the rules screen.
+type rulesItem int
+
+const (
+	MISERE_ITEM rulesItem = iota
+	WILD_ITEM
+	NOTAKTO_ITEM
+	MAX_SYMBOLS_ITEM
+	rulesItemCount // The number of rules items
+)
+
+// gameVariant returns the rule variant of the game mode: the variant of the settings, with the
+// symbol limit of the GoRythm mode when none is set.
+func (g *Game) gameVariant() rules.Variant {
+	variant := g.settings.Rules
+	if g.gameMode == GORYTHM_MODE && variant.MaxSymbols == 0 {
+		variant.MaxSymbols = goRythmMaxSymbols
+	}
+	return variant
+}
+
+// startPosition starts the rules of a local 3x3 game with the current player.
+func (g *Game) startPosition() {
+	g.position = rules.NewGame(rules.Symbol(g.currentPlayerSymbol), g.gameVariant())
+	g.wildSymbol = X_PLAYING
+}
+
+// pieceSymbol returns the symbol placed by the next move: the symbol of the current player,
+// X in the Notakto variant or the chosen symbol in the Wild variant.
+func (g *Game) pieceSymbol() SymbolPlaying {
+	if g.position == nil {
+		return g.currentPlayerSymbol
+	}
+	symbols := g.position.Variant.Symbols(rules.Symbol(g.currentPlayerSymbol))
+	if len(symbols) > 1 {
+		return g.wildSymbol
+	}
+	return SymbolPlaying(symbols[0])
+}
+
+// removalQueues returns the symbols of each player in the order they will be removed with
+// a symbol limit, the next one first, or nil without limit.
+func (g *Game) removalQueues() map[SymbolPlaying][][2]int {
+	queues := map[SymbolPlaying][][2]int{}
+	for _, player := range []SymbolPlaying{X_PLAYING, O_PLAYING} {
+		switch {
+		case g.position != nil && g.position.Variant.MaxSymbols > 0:
+			queues[player], _ = g.position.Queue(rules.Symbol(player))
+		case g.online != nil && g.onlineQueues[player] != nil:
+			queues[player] = g.onlineQueues[player].Cells()
+		default:
+			return nil
+		}
+	}
+	return queues
+}
+
+// switchWildSymbol changes the symbol placed by the next move in the Wild variant.
+func (g *Game) switchWildSymbol() {
+	if g.position != nil && g.position.Variant.Wild {
+		g.wildSymbol = SymbolPlaying(rules.Symbol(g.wildSymbol).Opponent())
+	}
+}
+
+// openRules opens the rules screen.
+func (g *Game) openRules() {
+	g.state = StateRules
+	g.rulesSelected = MISERE_ITEM
+	g.settingsError = ""
+}
+
+// handleStateRules handles the rules screen inputs. A variant is selected with the up and down
+// arrows and changed with the left and right arrows or Enter. Escape saves the rules in the
+// settings and returns to the menu.
+func (g *Game) handleStateRules() {
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyUp):
+		g.rulesSelected = (g.rulesSelected + rulesItemCount - 1) % rulesItemCount
+	case inpututil.IsKeyJustPressed(ebiten.KeyDown):
+		g.rulesSelected = (g.rulesSelected + 1) % rulesItemCount
+	case inpututil.IsKeyJustPressed(ebiten.KeyLeft):
+		g.settings.Rules = changeRule(g.settings.Rules, g.rulesSelected, -1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyRight), inpututil.IsKeyJustPressed(ebiten.KeyEnter):
+		g.settings.Rules = changeRule(g.settings.Rules, g.rulesSelected, 1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyEscape):
+		if err := g.settings.Save(); err != nil {
+			log.LogMessage(log.ERROR, "failed to save the rules: "+err.Error())
+			g.settingsError = err.Error()
+			return
+		}
+		g.state = StateMenu
+	}
+}
+
+// changeRule returns the variant with the given item toggled, or with the symbol limit increased
+// (direction 1) or decreased (direction -1) through no limit and the allowed limits.
+func changeRule(v rules.Variant, item rulesItem, direction int) rules.Variant {
+	switch item {
+	case MISERE_ITEM:
+		v.Misere = !v.Misere
+	case WILD_ITEM:
+		v.Wild = !v.Wild
+		if v.Wild {
+			v.Notakto = false
+		}
+	case NOTAKTO_ITEM:
+		v.Notakto = !v.Notakto
+	case MAX_SYMBOLS_ITEM:
+		limits := []int{0}
+		for limit := rules.MinSymbolLimit; limit <= rules.MaxSymbolLimit; limit++ {
+			limits = append(limits, limit)
+		}
+		i := 0
+		for j, limit := range limits {
+			if limit == v.MaxSymbols {
+				i = j
+			}
+		}
+		v.MaxSymbols = limits[(i+direction+len(limits))%len(limits)]
+	}
+	return v.Normalize()
+}
+
+// rulesLabel returns the label of a rules item with its current value.
+func rulesLabel(v rules.Variant, item rulesItem) string {
+	onOff := func(enabled bool) string {
+		if enabled {
+			return "on"
+		}
+		return "off"
+	}
+	switch item {
+	case MISERE_ITEM:
+		return "Misère (three in a row loses): " + onOff(v.Misere)
+	case WILD_ITEM:
+		return "Wild (each move places X or O): " + onOff(v.Wild)
+	case NOTAKTO_ITEM:
+		return "Notakto (both place X, three loses): " + onOff(v.Notakto)
+	case MAX_SYMBOLS_ITEM:
+		if v.MaxSymbols == 0 {
+			return "Max symbols per player: no limit"
+		}
+		return fmt.Sprintf("Max symbols per player: %d", v.MaxSymbols)
+	}
+	return ""
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/rules"
+	"testing"
+)
+
+// TestGame_performMove_goRythm tests the performMove function in GoRythm mode.
+// Checks if the oldest symbol of a player is removed when it places a fourth one.
+func TestGame_performMove_goRythm(t *testing.T) {
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.gameMode = GORYTHM_MODE
+	g.currentPlayerSymbol = X_PLAYING
+	g.startPosition()
+
+	for _, cell := range [][2]int{{0, 0}, {2, 2}, {1, 0}, {2, 1}, {0, 2}, {1, 2}} {
+		g.performMove(cell[0], cell[1])
+	}
+	g.performMove(1, 1)
+	if g.board[0][0] != NONE_PLAYING || g.board[1][1] != X_PLAYING {
+		t.Errorf("Expected the first X to be replaced, got %v", g.board)
+	}
+}
+
+// TestGame_removalQueues tests the removalQueues function.
+// Checks if the symbols are listed in the order they will be removed with a limit and if none are listed without.
+func TestGame_removalQueues(t *testing.T) {
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.gameMode = CLASSIC_PVP_MODE
+	g.currentPlayerSymbol = X_PLAYING
+	g.startPosition()
+	if queues := g.removalQueues(); queues != nil {
+		t.Errorf("Expected no queues without limit, got %v", queues)
+	}
+
+	g.gameMode = GORYTHM_MODE
+	g.startPosition()
+	for _, cell := range [][2]int{{0, 0}, {2, 2}, {1, 0}, {2, 1}} {
+		g.performMove(cell[0], cell[1])
+	}
+	queues := g.removalQueues()
+	if x := queues[X_PLAYING]; len(x) != 2 || x[0] != [2]int{0, 0} || x[1] != [2]int{1, 0} {
+		t.Errorf("Expected X queue [[0 0] [1 0]], got %v", x)
+	}
+	if o := queues[O_PLAYING]; len(o) != 2 || o[0] != [2]int{2, 2} {
+		t.Errorf("Expected O queue to start with [2 2], got %v", o)
+	}
+}
+
+// TestGame_boardWinner_misere tests the boardWinner function with the misère variant.
+// Checks if the player aligning three symbols loses.
+func TestGame_boardWinner_misere(t *testing.T) {
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.gameMode = CLASSIC_PVP_MODE
+	g.settings.Rules = rules.Variant{Misere: true}
+	g.currentPlayerSymbol = X_PLAYING
+	g.startPosition()
+
+	for _, cell := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}} {
+		g.performMove(cell[0], cell[1])
+	}
+	if winner := g.boardWinner(); winner != O_PLAYING {
+		t.Errorf("Expected O to win, got %q", winner)
+	}
+}
+
+// TestChangeRule tests the changeRule function.
+// Checks if the variants are toggled and if the symbol limit cycles through no limit.
+func TestChangeRule(t *testing.T) {
+	v := changeRule(rules.Variant{Notakto: true}, WILD_ITEM, 1)
+	if !v.Wild || v.Notakto {
+		t.Errorf("Expected Wild to replace Notakto, got %+v", v)
+	}
+	v = changeRule(v, MAX_SYMBOLS_ITEM, 1)
+	if v.MaxSymbols != rules.MinSymbolLimit {
+		t.Errorf("Expected %d symbols, got %d", rules.MinSymbolLimit, v.MaxSymbols)
+	}
+	v = changeRule(rules.Variant{}, MAX_SYMBOLS_ITEM, -1)
+	if v.MaxSymbols != rules.MaxSymbolLimit {
+		t.Errorf("Expected %d symbols, got %d", rules.MaxSymbolLimit, v.MaxSymbols)
+	}
+}
+
+// TestGame_playHumanMove_refused tests the playHumanMove function with a refused move.
+// Checks if a move refused by the rules is neither scored on the beat nor counted as a hint.
+func TestGame_playHumanMove_refused(t *testing.T) {
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.gameMode = GORYTHM_MODE
+	g.goRythm = NewGoRythm()
+	g.currentPlayerSymbol = X_PLAYING
+	g.startPosition()
+	g.analysis = true
+	// X wins, the position refuses the next moves
+	for _, cell := range [][2]int{{0, 0}, {0, 1}, {1, 0}, {1, 1}, {2, 0}} {
+		g.performMove(cell[0], cell[1])
+	}
+	points, tally := g.pointsO, g.tallyO
+
+	g.playHumanMove(2, 2)
+	if g.board[2][2] != NONE_PLAYING || g.rounds != 5 {
+		t.Fatalf("Expected the move to be refused, got %v after %d rounds", g.board, g.rounds)
+	}
+	if g.pointsO != points || g.tallyO != tally {
+		t.Errorf("Expected the refused move not to be counted, got %d points and %+v", g.pointsO, g.tallyO)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"GoRythm/internal/ai"
+	"fmt"
+	"time"
+)
+
+const (
+	watchDelay = 600 * time.Millisecond // The time between two moves of the AI in the watch mode
+)
+
+// watchPairs returns the pairs of registered strategies that can be watched, the strategy of X first.
+func watchPairs() [][2]string {
+	var pairs [][2]string
+	for _, x := range ai.Names() {
+		for _, o := range ai.Names() {
+			pairs = append(pairs, [2]string{x, o})
+		}
+	}
+	return pairs
+}
+
+// watchPair returns the strategies of X and O selected for the watch mode.
+func (g *Game) watchPair() [2]string {
+	pairs := watchPairs()
+	return pairs[g.watchPreset%len(pairs)]
+}
+
+// watchLabel returns the strategies watched, as shown in the menu and during the game.
+func (g *Game) watchLabel() string {
+	pair := g.watchPair()
+	return fmt.Sprintf("X %s vs O %s", pair[0], pair[1])
+}
+
+// selectWatch selects the watch mode, or the next pair of strategies when it is already selected.
+func (g *Game) selectWatch() {
+	if g.gameMode == WATCH_MODE {
+		g.watchPreset = (g.watchPreset + 1) % len(watchPairs())
+	}
+	g.gameMode = WATCH_MODE
+}
+
+// startWatch starts a 3x3 game between the two strategies with the rule variant.
+func (g *Game) startWatch() {
+	g.startPosition()
+	g.currentPlayerType = AI_TYPE
+}
+
+// watchMove plays the move of the strategy of the current player, thinking at least watchDelay
+// so that the game can be followed.
+func (g *Game) watchMove() {
+	pair := g.watchPair()
+	name := pair[0]
+	if g.currentPlayerSymbol == O_PLAYING {
+		name = pair[1]
+	}
+	g.updateAI(name, watchDelay)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestGame_selectWatch tests the selectWatch function.
+// Checks if the watch mode is selected first, then if the next pair of strategies is selected.
+func TestGame_selectWatch(t *testing.T) {
+	g := NewGame()
+	g.selectWatch()
+	first := g.watchPair()
+	if g.gameMode != WATCH_MODE {
+		t.Errorf("Expected the watch mode, got %v", g.gameMode)
+	}
+	g.selectWatch()
+	if g.watchPair() == first {
+		t.Errorf("Expected another pair than %v", first)
+	}
+	for range watchPairs() {
+		g.selectWatch()
+	}
+	if g.watchPreset >= len(watchPairs()) {
+		t.Errorf("Expected the pairs to cycle, got index %d", g.watchPreset)
+	}
+}
+
+// TestGame_watchMove tests the watchMove function.
+// Checks if the AI thinks at least the watch delay, then plays for both players.
+func TestGame_watchMove(t *testing.T) {
+	g := NewGame()
+	if err := g.Init(audioContext, sWidth, sHeight); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	g.gameMode = WATCH_MODE
+	g.currentPlayerSymbol = X_PLAYING
+	g.startWatch()
+
+	g.watchMove()
+	if !g.aiThinking() || g.rounds != 0 {
+		t.Fatalf("Expected no move before the delay, got %d", g.rounds)
+	}
+	for _, player := range []SymbolPlaying{X_PLAYING, O_PLAYING} {
+		deadline := time.Now().Add(5 * time.Second)
+		for rounds := g.rounds; g.rounds == rounds && time.Now().Before(deadline); {
+			if g.aiThinking() {
+				g.aiJob.start = time.Now().Add(-watchDelay)
+			}
+			g.watchMove()
+			time.Sleep(time.Millisecond)
+		}
+		symbols := 0
+		for x := 0; x < 3; x++ {
+			for y := 0; y < 3; y++ {
+				if g.board[x][y] == player {
+					symbols++
+				}
+			}
+		}
+		if symbols != 1 {
+			t.Errorf("Expected one %v on the board, got %d", player, symbols)
+		}
+	}
+	if g.rounds != 2 || g.currentPlayerType != AI_TYPE {
+		t.Errorf("Expected 2 rounds played by the AI, got %d with %v", g.rounds, g.currentPlayerType)
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package ai
+
+import (
+	"GoRythm/internal/cube"
+	"GoRythm/internal/rules"
+	"GoRythm/internal/ultimate"
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// TestClassicMove tests the ClassicMove and ClassicCell functions.
+// Checks if each move of a 3x3 game is numbered once and decoded as encoded.
+func TestClassicMove(t *testing.T) {
+	seen := map[int]bool{}
+	for x := 0; x < 3; x++ {
+		for y := 0; y < 3; y++ {
+			for _, symbol := range []rules.Symbol{rules.X, rules.O} {
+				m := rules.Move{X: x, Y: y, Symbol: symbol}
+				move := ClassicMove(m)
+				if seen[move] {
+					t.Fatalf("Expected %v to have its own number, got %d twice", m, move)
+				}
+				seen[move] = true
+				if decoded := ClassicCell(move); decoded != m {
+					t.Errorf("Expected %v, got %v", m, decoded)
+				}
+			}
+		}
+	}
+}
+
+// TestPositionMoves tests the Moves and Play functions of the positions.
+// Checks if the moves of each kind of game can be played and are decoded on the right cell.
+func TestPositionMoves(t *testing.T) {
+	classic := NewClassic(rules.X, rules.Variant{})
+	if err := classic.Play(ClassicMove(rules.Move{X: 2, Y: 1, Symbol: rules.X})); err != nil || classic.Game.Board[2][1] != rules.X {
+		t.Errorf("Expected X at [2 1], got %v", err)
+	}
+
+	u := NewUltimate(rules.O)
+	if err := u.Play(UltimateMove(ultimate.Move{X: 7, Y: 4})); err != nil || u.Game.Cell(7, 4) != rules.O {
+		t.Errorf("Expected O at [7 4], got %v", err)
+	}
+	if moves := u.Moves(); len(moves) != 9 {
+		t.Errorf("Expected 9 moves in the sub-board, got %d", len(moves))
+	}
+
+	c, err := NewCube(4, rules.X)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	m := cube.Move{X: 1, Y: 3, Z: 2}
+	if err := c.Play(CubeMove(4, m)); err != nil || c.Game.Cell(m) != rules.X {
+		t.Errorf("Expected X at %v, got %v", m, err)
+	}
+	if len(c.Moves()) != 63 {
+		t.Errorf("Expected 63 moves, got %d", len(c.Moves()))
+	}
+}
+
+// TestPosition_Clone tests the Clone function of the positions.
+// Checks if playing on a clone does not change the original position.
+func TestPosition_Clone(t *testing.T) {
+	cubePosition, _ := NewCube(3, rules.X)
+	for _, p := range []Position{NewClassic(rules.X, rules.Variant{MaxSymbols: 3}), NewUltimate(rules.X), cubePosition} {
+		moves := len(p.Moves())
+		clone := p.Clone()
+		if err := clone.Play(clone.Moves()[0]); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if p.Turn() != rules.X || len(p.Moves()) != moves {
+			t.Errorf("Expected the original %T to be unchanged", p)
+		}
+	}
+}
+
+// TestNew tests the New and Names functions.
+// Checks if the built-in strategies are registered and if an unknown name returns an error.
+func TestNew(t *testing.T) {
+	names := Names()
+	for _, name := range []string{"mcts", "minimax", "random"} {
+		if !slices.Contains(names, name) {
+			t.Errorf("Expected %q to be registered, got %v", name, names)
+		}
+		if s, err := New(name); err != nil || s == nil {
+			t.Errorf("Expected the %q strategy, got %v", name, err)
+		}
+	}
+	if _, err := New("nope"); !errors.Is(err, ErrUnknownStrategy) {
+		t.Errorf("Expected %v, got %v", ErrUnknownStrategy, err)
+	}
+}
+
+// TestRegister tests the Register function.
+// Checks if registering a name twice panics.
+func TestRegister(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic")
+		}
+	}()
+	Register("random", func() Strategy { return Random{} })
+}
+
+// TestRandom_Move tests the Move function of the Random strategy.
+// Checks if the move is legal, the same with the same seed, and if a finished game returns an error.
+func TestRandom_Move(t *testing.T) {
+	p := NewUltimate(rules.X)
+	first, err := Random{}.Move(p, rand.New(rand.NewSource(7)))
+	if err != nil || !slices.Contains(p.Moves(), first) {
+		t.Fatalf("Expected a legal move, got %d %v", first, err)
+	}
+	if again, _ := (Random{}).Move(p, rand.New(rand.NewSource(7))); again != first {
+		t.Errorf("Expected the same move with the same seed, got %d and %d", first, again)
+	}
+
+	over := NewClassic(rules.X, rules.Variant{})
+	for _, cell := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}} {
+		over.Play(ClassicMove(rules.Move{X: cell[0], Y: cell[1], Symbol: over.Turn()}))
+	}
+	if _, err := (Random{}).Move(over, rand.New(rand.NewSource(1))); !errors.Is(err, ErrNoMove) {
+		t.Errorf("Expected %v, got %v", ErrNoMove, err)
+	}
+}
+
+// TestMinimax_Move tests the Move function of the Minimax strategy.
+// Checks if the AI wins immediately as X or O, and blocks the opponent otherwise.
+func TestMinimax_Move(t *testing.T) {
+	for _, player := range []rules.Symbol{rules.X, rules.O} {
+		p := NewClassic(player, rules.Variant{})
+		// The player can complete the first column, the opponent the second one
+		for _, cell := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}} {
+			p.Play(ClassicMove(rules.Move{X: cell[0], Y: cell[1], Symbol: p.Turn()}))
+		}
+		move, err := Minimax{}.Move(p, rand.New(rand.NewSource(1)))
+		want := rules.Move{X: 0, Y: 2, Symbol: player}
+		if err != nil || ClassicCell(move) != want {
+			t.Errorf("Expected %v, got %v %v", want, ClassicCell(move), err)
+		}
+	}
+
+	p := NewClassic(rules.X, rules.Variant{})
+	for _, cell := range [][2]int{{0, 0}, {1, 1}, {2, 2}, {0, 1}} {
+		p.Play(ClassicMove(rules.Move{X: cell[0], Y: cell[1], Symbol: p.Turn()}))
+	}
+	move, _ := Minimax{}.Move(p, rand.New(rand.NewSource(1)))
+	if want := (rules.Move{X: 2, Y: 1, Symbol: rules.X}); ClassicCell(move) != want {
+		t.Errorf("Expected X to block at %v, got %v", want, ClassicCell(move))
+	}
+}
+
+// TestMinimax_Move_misere tests the Move function of the Minimax strategy in the misère variant.
+// Checks if the AI avoids aligning its symbols.
+func TestMinimax_Move_misere(t *testing.T) {
+	p := NewClassic(rules.X, rules.Variant{Misere: true})
+	for _, cell := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {2, 1}, {2, 2}} {
+		p.Play(ClassicMove(rules.Move{X: cell[0], Y: cell[1], Symbol: p.Turn()}))
+	}
+	move, err := Minimax{}.Move(p, rand.New(rand.NewSource(1)))
+	if losing := (rules.Move{X: 0, Y: 2, Symbol: rules.X}); err != nil || ClassicCell(move) == losing {
+		t.Errorf("Expected the AI to avoid aligning its symbols, got %v %v", ClassicCell(move), err)
+	}
+}
+
+// TestMinimax_Move_ultimate tests the Move function of the Minimax strategy on an Ultimate game.
+// Checks if the AI wins the game when the winning sub-board can be won in one move, and blocks
+// the sub-board that would win the game of the opponent.
+func TestMinimax_Move_ultimate(t *testing.T) {
+	for _, owner := range []rules.Symbol{rules.X, rules.O} {
+		g := ultimate.New(rules.X)
+		g.Meta[0][0], g.Meta[1][1] = owner, owner
+		g.Boards[2][2] = rules.Board{{owner, owner, rules.None}}
+		g.Next = [2]int{2, 2}
+		move, err := Minimax{}.Move(&Ultimate{Game: g}, rand.New(rand.NewSource(1)))
+		if want := (ultimate.Move{X: 6, Y: 8}); err != nil || UltimateCell(move) != want {
+			t.Errorf("Expected X to play %v against the sub-boards of %s, got %v %v", want, owner, UltimateCell(move), err)
+		}
+	}
+}
+
+// TestMinimax_Move_cube tests the Move function of the Minimax strategy on a 3D game.
+// Checks if the AI completes its line and blocks the line of its opponent.
+func TestMinimax_Move_cube(t *testing.T) {
+	p, _ := NewCube(4, rules.X)
+	for _, m := range []cube.Move{{X: 0, Y: 0, Z: 2}, {X: 3, Y: 3, Z: 3}, {X: 1, Y: 0, Z: 2}, {X: 3, Y: 2, Z: 3}, {X: 2, Y: 0, Z: 2}, {X: 0, Y: 3, Z: 0}} {
+		p.Play(CubeMove(4, m))
+	}
+	move, err := Minimax{}.Move(p, rand.New(rand.NewSource(1)))
+	if want := (cube.Move{X: 3, Y: 0, Z: 2}); err != nil || CubeCell(4, move) != want {
+		t.Errorf("Expected the winning move %v, got %v %v", want, CubeCell(4, move), err)
+	}
+
+	p, _ = NewCube(3, rules.O)
+	for _, m := range []cube.Move{{X: 0, Y: 0, Z: 0}, {X: 2, Y: 2, Z: 0}, {X: 1, Y: 1, Z: 1}} {
+		p.Play(CubeMove(3, m))
+	}
+	move, err = Minimax{}.Move(p, rand.New(rand.NewSource(1)))
+	if want := (cube.Move{X: 2, Y: 2, Z: 2}); err != nil || CubeCell(3, move) != want {
+		t.Errorf("Expected the blocking move %v, got %v %v", want, CubeCell(3, move), err)
+	}
+}
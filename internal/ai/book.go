@@ -0,0 +1,99 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package ai
+
+import (
+	"GoRythm/internal/rules"
+	"math/rand"
+	"slices"
+)
+
+// A bookEntry struct contains a position of the first moves of a classic game, seen by the
+// player to move who plays X, and good moves of the position.
+type bookEntry struct {
+	board rules.Board
+	moves [][2]int
+}
+
+// bookEntries contains the positions of the opening book. The symmetric positions and moves
+// are found by the book.
+var bookEntries = []bookEntry{
+	// The first move: a corner or the center
+	{rules.Board{}, [][2]int{{0, 0}, {1, 1}}},
+	// Against the center, only a corner draws
+	{rules.Board{{}, {rules.None, rules.O}}, [][2]int{{0, 0}}},
+	// Against a corner, only the center draws
+	{rules.Board{{rules.O}}, [][2]int{{1, 1}}},
+	// Against an edge: the center, a corner next to it or the opposite edge
+	{rules.Board{{}, {rules.O}}, [][2]int{{1, 1}, {0, 0}, {1, 2}}},
+	// The center answered by a corner: the opposite corner
+	{rules.Board{{rules.O}, {rules.None, rules.X}}, [][2]int{{2, 2}}},
+	// A corner answered by the center: the opposite corner
+	{rules.Board{{rules.X}, {rules.None, rules.O}}, [][2]int{{2, 2}}},
+}
+
+// openingBook contains the cells of the good moves by key of the canonical board of the book
+// entries, in the orientation of the canonical board.
+var openingBook = map[uint32][][2]int{}
+
+func init() {
+	for _, entry := range bookEntries {
+		canonical, s := entry.board.Canonical()
+		for _, cell := range entry.moves {
+			x, y := s.Apply(cell[0], cell[1])
+			openingBook[canonical.Key()] = append(openingBook[canonical.Key()], [2]int{x, y})
+		}
+	}
+}
+
+// BookMoves returns the moves of the opening book for the player to move in a classic game,
+// with all their symmetric moves, or nil if the position is not in the book.
+func BookMoves(p *Classic) []int {
+	if !p.Game.Variant.Classic() || p.Game.Over() {
+		return nil
+	}
+	// The book is seen by the player to move, who plays X
+	board := p.Game.Board
+	if p.Game.Turn == rules.O {
+		for x := 0; x < 3; x++ {
+			for y := 0; y < 3; y++ {
+				board[x][y] = board[x][y].Opponent()
+			}
+		}
+	}
+	canonical, s := board.Canonical()
+	cells, ok := openingBook[canonical.Key()]
+	if !ok {
+		return nil
+	}
+	var book []int
+	for _, cell := range cells {
+		x, y := s.Inverse().Apply(cell[0], cell[1])
+		book = append(book, ClassicMove(rules.Move{X: x, Y: y, Symbol: p.Game.Turn}))
+	}
+	var moves []int
+	for _, group := range p.SymmetricMoves(p.Moves()) {
+		for _, move := range book {
+			if slices.Contains(group, move) {
+				moves = append(moves, group...)
+				break
+			}
+		}
+	}
+	return moves
+}
+
+// bookMove returns a random move of the opening book and true, or false if the position is
+// not in the book.
+func bookMove(p Position, r *rand.Rand) (int, bool) {
+	classic, ok := p.(*Classic)
+	if !ok {
+		return 0, false
+	}
+	moves := BookMoves(classic)
+	if len(moves) == 0 {
+		return 0, false
+	}
+	return moves[r.Intn(len(moves))], true
+}
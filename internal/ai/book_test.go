@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package ai
+
+import (
+	"GoRythm/internal/rules"
+	"GoRythm/internal/solver"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// TestBookMoves tests the BookMoves function.
+// Checks the symmetric moves of the first move and if every book move keeps the value of its position.
+func TestBookMoves(t *testing.T) {
+	first := BookMoves(NewClassic(rules.X, rules.Variant{}))
+	if len(first) != 5 {
+		t.Errorf("Expected the 4 corners and the center, got %v", first)
+	}
+
+	s, err := solver.New(rules.Variant{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	// Every position of the first three moves, started by X or O
+	var visit func(p *Classic, depth int)
+	found := 0
+	visit = func(p *Classic, depth int) {
+		book := BookMoves(p)
+		if len(book) > 0 {
+			found++
+			best, _ := s.Value(p.Game.Board, p.Game.Turn)
+			for _, move := range book {
+				next := p.Clone().(*Classic)
+				next.Play(move)
+				if v, _ := s.Value(next.Game.Board, next.Game.Turn); v.Outcome != -best.Outcome {
+					t.Errorf("Expected the book move %v to keep the %s of %v, got a %s", ClassicCell(move), best.Outcome, p.Game.Board, -v.Outcome)
+				}
+			}
+		}
+		if depth == 0 {
+			return
+		}
+		for _, move := range p.Moves() {
+			next := p.Clone().(*Classic)
+			next.Play(move)
+			visit(next, depth-1)
+		}
+	}
+	visit(NewClassic(rules.X, rules.Variant{}), 3)
+	visit(NewClassic(rules.O, rules.Variant{}), 3)
+	if found == 0 {
+		t.Error("Expected positions in the book")
+	}
+
+	if moves := BookMoves(NewClassic(rules.X, rules.Variant{Misere: true})); moves != nil {
+		t.Errorf("Expected no book in the misère variant, got %v", moves)
+	}
+}
+
+// TestClassic_SymmetricMoves tests the SymmetricMoves function.
+// Checks the groups of the empty board, after a corner, and with a symbol limit.
+func TestClassic_SymmetricMoves(t *testing.T) {
+	p := NewClassic(rules.X, rules.Variant{})
+	if groups := p.SymmetricMoves(p.Moves()); len(groups) != 3 {
+		t.Errorf("Expected the corners, the edges and the center, got %v", groups)
+	}
+	p.Play(ClassicMove(rules.Move{X: 0, Y: 0, Symbol: rules.X}))
+	groups := p.SymmetricMoves(p.Moves())
+	if len(groups) != 5 {
+		t.Errorf("Expected 5 groups after a corner, got %v", groups)
+	}
+	edge := ClassicMove(rules.Move{X: 1, Y: 0, Symbol: rules.O})
+	for _, group := range groups {
+		if slices.Contains(group, edge) && !slices.Contains(group, ClassicMove(rules.Move{X: 0, Y: 1, Symbol: rules.O})) {
+			t.Errorf("Expected the edges next to the corner together, got %v", group)
+		}
+	}
+
+	limited := NewClassic(rules.X, rules.Variant{MaxSymbols: 3})
+	for _, cell := range [][2]int{{1, 0}, {1, 1}, {0, 1}} {
+		limited.Play(ClassicMove(rules.Move{X: cell[0], Y: cell[1], Symbol: limited.Turn()}))
+	}
+	// The board is symmetric across the diagonal but the edges of X are removed in another order
+	if groups := limited.SymmetricMoves(limited.Moves()); len(groups) != 6 {
+		t.Errorf("Expected no symmetric moves, got %v", groups)
+	}
+}
+
+// TestMinimax_Move_random tests the Move function of the Minimax strategy with different seeds.
+// Checks if different best moves are played, and only the moves of the book when it is used.
+func TestMinimax_Move_random(t *testing.T) {
+	p := NewClassic(rules.X, rules.Variant{})
+	book := BookMoves(p)
+	played := map[int]bool{}
+	for seed := int64(0); seed < 20; seed++ {
+		move, err := Minimax{}.Move(p, rand.New(rand.NewSource(seed)))
+		if err != nil || !slices.Contains(book, move) {
+			t.Errorf("Expected a move of the book, got %v %v", ClassicCell(move), err)
+		}
+		played[move] = true
+	}
+	if len(played) < 2 {
+		t.Errorf("Expected different first moves, got %v", played)
+	}
+
+	// X can win at (0, 2) or (2, 0)
+	p = NewClassic(rules.X, rules.Variant{})
+	for _, cell := range [][2]int{{0, 0}, {1, 1}, {1, 0}, {2, 2}, {0, 1}, {2, 1}} {
+		p.Play(ClassicMove(rules.Move{X: cell[0], Y: cell[1], Symbol: p.Turn()}))
+	}
+	wins := map[int]bool{}
+	for seed := int64(0); seed < 20; seed++ {
+		move, _ := Minimax{NoBook: true}.Move(p, rand.New(rand.NewSource(seed)))
+		wins[move] = true
+	}
+	want := map[int]bool{ClassicMove(rules.Move{X: 0, Y: 2, Symbol: rules.X}): true, ClassicMove(rules.Move{X: 2, Y: 0, Symbol: rules.X}): true}
+	if !maps.Equal(wins, want) {
+		t.Errorf("Expected both winning moves %v, got %v", want, wins)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package ai
+
+import (
+	"GoRythm/internal/rules"
+	"context"
+	"math"
+	"math/rand"
+)
+
+const (
+	DefaultPlayouts        = 2000 // The default number of playouts of a move
+	DefaultExploration     = math.Sqrt2
+	DefaultMaxPlayoutMoves = 100 // The default number of moves after which a playout is a draw
+
+	deadlineCheck = 64 // The number of playouts between two checks of the deadline
+)
+
+func init() {
+	Register("mcts", func() Strategy { return MCTS{} })
+}
+
+// An MCTS strategy plays the move found by a Monte Carlo tree search: the tree of the moves is
+// grown by random playouts, choosing the moves to explore with the UCT formula. It needs no
+// heuristic and its time only depends on the number of playouts, so it plays the large boards
+// and the endless variants where a full-width search is too slow.
+type MCTS struct {
+	Playouts        int     // The number of playouts of a move, DefaultPlayouts if 0
+	Exploration     float64 // The UCT exploration constant, DefaultExploration if 0
+	MaxPlayoutMoves int     // The number of moves after which a playout is a draw, DefaultMaxPlayoutMoves if 0
+}
+
+// A node struct contains a move of the search tree and the results of the playouts through it.
+type node struct {
+	move     int
+	player   rules.Symbol // The player who played the move
+	parent   *node
+	children []*node
+	untried  []int   // The moves of the position of the node without child yet
+	visits   int     // The number of playouts through the node
+	score    float64 // The points of the player of the move in the playouts: one per win, a half per draw
+}
+
+// Move returns the move explored by the most playouts.
+func (s MCTS) Move(p Position, r *rand.Rand) (int, error) {
+	return s.MoveContext(context.Background(), p, r)
+}
+
+// MoveContext returns the move explored by the most playouts, the search stops early when the
+// context is done.
+func (s MCTS) MoveContext(ctx context.Context, p Position, r *rand.Rand) (int, error) {
+	moves := p.Moves()
+	if len(moves) == 0 {
+		return 0, ErrNoMove
+	}
+	if len(moves) == 1 {
+		return moves[0], nil
+	}
+	playouts := s.Playouts
+	if playouts <= 0 {
+		playouts = DefaultPlayouts
+	}
+	exploration := s.Exploration
+	if exploration <= 0 {
+		exploration = DefaultExploration
+	}
+	maxMoves := s.MaxPlayoutMoves
+	if maxMoves <= 0 {
+		maxMoves = DefaultMaxPlayoutMoves
+	}
+
+	root := &node{player: p.Turn().Opponent(), untried: moves}
+	for i := 0; i < playouts; i++ {
+		if i > 0 && i%deadlineCheck == 0 && ctx.Err() != nil {
+			break
+		}
+		n := root
+		position := p.Clone()
+
+		// Select the most promising node fully expanded
+		for len(n.untried) == 0 && len(n.children) > 0 {
+			n = n.selectChild(exploration)
+			if err := position.Play(n.move); err != nil {
+				return 0, err
+			}
+		}
+		// Expand it with a random untried move
+		if len(n.untried) > 0 {
+			k := r.Intn(len(n.untried))
+			move := n.untried[k]
+			n.untried[k] = n.untried[len(n.untried)-1]
+			n.untried = n.untried[:len(n.untried)-1]
+			player := position.Turn()
+			if err := position.Play(move); err != nil {
+				return 0, err
+			}
+			child := &node{move: move, player: player, parent: n, untried: position.Moves()}
+			n.children = append(n.children, child)
+			n = child
+		}
+		// Play randomly until the end and propagate the result up to the root
+		winner := playout(position, r, maxMoves)
+		for ; n != nil; n = n.parent {
+			n.visits++
+			if winner == n.player {
+				n.score++
+			} else if winner == rules.None {
+				n.score += 0.5
+			}
+		}
+	}
+
+	best := root.children[0]
+	for _, child := range root.children {
+		if child.visits > best.visits {
+			best = child
+		}
+	}
+	return best.move, nil
+}
+
+// selectChild returns the child with the highest UCT value: its mean score plus an exploration
+// bonus for the children visited less than their siblings.
+func (n *node) selectChild(exploration float64) *node {
+	var best *node
+	bestValue := math.Inf(-1)
+	logVisits := math.Log(float64(n.visits))
+	for _, child := range n.children {
+		value := child.score/float64(child.visits) + exploration*math.Sqrt(logVisits/float64(child.visits))
+		if value > bestValue {
+			best = child
+			bestValue = value
+		}
+	}
+	return best
+}
+
+// playout plays random moves on the position until the end of the game, or until the maximum
+// number of moves for a draw, and returns the winner.
+func playout(p Position, r *rand.Rand, maxMoves int) rules.Symbol {
+	for moves := 0; moves < maxMoves; moves++ {
+		legal := p.Moves()
+		if len(legal) == 0 {
+			break
+		}
+		p.Play(legal[r.Intn(len(legal))])
+	}
+	return p.Winner()
+}
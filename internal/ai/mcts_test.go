@@ -0,0 +1,71 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package ai
+
+import (
+	"GoRythm/internal/rules"
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// TestMCTS_Move tests the Move function of the MCTS strategy.
+// Checks if the AI wins immediately as X or O, and blocks the opponent otherwise.
+func TestMCTS_Move(t *testing.T) {
+	for _, player := range []rules.Symbol{rules.X, rules.O} {
+		p := NewClassic(player, rules.Variant{})
+		// The player can complete the first column, the opponent the second one
+		for _, cell := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}} {
+			p.Play(ClassicMove(rules.Move{X: cell[0], Y: cell[1], Symbol: p.Turn()}))
+		}
+		move, err := MCTS{}.Move(p, rand.New(rand.NewSource(1)))
+		want := rules.Move{X: 0, Y: 2, Symbol: player}
+		if err != nil || ClassicCell(move) != want {
+			t.Errorf("Expected %v, got %v %v", want, ClassicCell(move), err)
+		}
+	}
+
+	p := NewClassic(rules.X, rules.Variant{})
+	for _, cell := range [][2]int{{0, 0}, {1, 1}, {2, 2}, {0, 1}} {
+		p.Play(ClassicMove(rules.Move{X: cell[0], Y: cell[1], Symbol: p.Turn()}))
+	}
+	move, _ := MCTS{}.Move(p, rand.New(rand.NewSource(1)))
+	if want := (rules.Move{X: 2, Y: 1, Symbol: rules.X}); ClassicCell(move) != want {
+		t.Errorf("Expected X to block at %v, got %v", want, ClassicCell(move))
+	}
+
+	if _, err := (MCTS{}).Move(NewClassic(rules.X, rules.Variant{}), rand.New(rand.NewSource(1))); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+// TestMCTS_Move_large tests the Move function of the MCTS strategy on the large boards.
+// Checks if a legal move is found with a small budget, the same with the same seed.
+func TestMCTS_Move_large(t *testing.T) {
+	cubePosition, _ := NewCube(4, rules.X)
+	endless := NewClassic(rules.X, rules.Variant{Wild: true, MaxSymbols: 3})
+	for _, p := range []Position{NewUltimate(rules.X), cubePosition, endless} {
+		s := MCTS{Playouts: 200, Exploration: 1, MaxPlayoutMoves: 30}
+		move, err := s.Move(p, rand.New(rand.NewSource(3)))
+		if err != nil || !slices.Contains(p.Moves(), move) {
+			t.Errorf("Expected a legal move on %T, got %d %v", p, move, err)
+		}
+		if again, _ := s.Move(p, rand.New(rand.NewSource(3))); again != move {
+			t.Errorf("Expected the same move on %T with the same seed, got %d and %d", p, move, again)
+		}
+	}
+}
+
+// TestMCTS_Move_over tests the Move function of the MCTS strategy on a finished game.
+// Checks if an error is returned.
+func TestMCTS_Move_over(t *testing.T) {
+	p := NewClassic(rules.X, rules.Variant{})
+	for _, cell := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}} {
+		p.Play(ClassicMove(rules.Move{X: cell[0], Y: cell[1], Symbol: p.Turn()}))
+	}
+	if _, err := (MCTS{}).Move(p, rand.New(rand.NewSource(1))); !errors.Is(err, ErrNoMove) {
+		t.Errorf("Expected %v, got %v", ErrNoMove, err)
+	}
+}
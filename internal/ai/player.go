@@ -0,0 +1,85 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package ai
+
+import (
+	"GoRythm/internal/rules"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+)
+
+var (
+	ErrNotYourTurn = errors.New("it is not the turn of the side")
+)
+
+// An AIPlayer chooses the moves of one side of a game, X or O. The move must be returned before
+// the deadline of the context, if any.
+type AIPlayer interface {
+	Move(ctx context.Context, p Position, side rules.Symbol) (int, error)
+}
+
+// A ContextStrategy is a Strategy able to stop its search when the context is done. It then
+// returns the best move found so far instead of an error.
+type ContextStrategy interface {
+	Strategy
+	MoveContext(ctx context.Context, p Position, r *rand.Rand) (int, error)
+}
+
+// A strategyPlayer struct is the AIPlayer of a strategy.
+type strategyPlayer struct {
+	strategy Strategy
+	random   *rand.Rand
+}
+
+// NewPlayer returns the AIPlayer of the strategy registered under the name, drawing its random
+// numbers from the generator.
+func NewPlayer(name string, r *rand.Rand) (AIPlayer, error) {
+	s, err := New(name)
+	if err != nil {
+		return nil, err
+	}
+	return PlayerOf(s, r), nil
+}
+
+// PlayerOf returns the AIPlayer of the strategy, drawing its random numbers from the generator.
+func PlayerOf(s Strategy, r *rand.Rand) AIPlayer {
+	return &strategyPlayer{strategy: s, random: r}
+}
+
+// Move returns the move of the strategy for the side, which must be the player to move. The
+// strategy plays on a copy of the position. A ContextStrategy returns its best move at the
+// deadline, the other strategies are run in a goroutine and the error of the context is
+// returned if they are too slow.
+func (s *strategyPlayer) Move(ctx context.Context, p Position, side rules.Symbol) (int, error) {
+	if p.Turn() != side {
+		return 0, fmt.Errorf("%w: %s to move, not %s", ErrNotYourTurn, p.Turn(), side)
+	}
+	if c, ok := s.strategy.(ContextStrategy); ok {
+		return c.MoveContext(ctx, p.Clone(), s.random)
+	}
+	if ctx.Done() == nil {
+		return s.strategy.Move(p.Clone(), s.random)
+	}
+
+	type result struct {
+		move int
+		err  error
+	}
+	// The generator is not shared with a goroutine which may outlive the call
+	r := rand.New(rand.NewSource(s.random.Int63()))
+	position := p.Clone()
+	results := make(chan result, 1)
+	go func() {
+		move, err := s.strategy.Move(position, r)
+		results <- result{move, err}
+	}()
+	select {
+	case res := <-results:
+		return res.move, res.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
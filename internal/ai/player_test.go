@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package ai
+
+import (
+	"GoRythm/internal/rules"
+	"context"
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+// slowStrategy is a strategy taking a second to play its first legal move.
+type slowStrategy struct{}
+
+// Move returns the first legal move after a second.
+func (slowStrategy) Move(p Position, r *rand.Rand) (int, error) {
+	time.Sleep(time.Second)
+	return p.Moves()[0], nil
+}
+
+// TestNewPlayer tests the NewPlayer function.
+// Checks if the player of a registered strategy is returned and if an unknown name returns an error.
+func TestNewPlayer(t *testing.T) {
+	if p, err := NewPlayer("minimax", rand.New(rand.NewSource(1))); err != nil || p == nil {
+		t.Errorf("Expected a player, got %v", err)
+	}
+	if _, err := NewPlayer("nope", rand.New(rand.NewSource(1))); !errors.Is(err, ErrUnknownStrategy) {
+		t.Errorf("Expected %v, got %v", ErrUnknownStrategy, err)
+	}
+}
+
+// TestAIPlayer_Move tests the Move function of the player of a strategy.
+// Checks if the AI plays either side and if it refuses to play out of turn.
+func TestAIPlayer_Move(t *testing.T) {
+	player, _ := NewPlayer("minimax", rand.New(rand.NewSource(1)))
+	p := NewClassic(rules.O, rules.Variant{})
+	// O can complete the first column
+	for _, cell := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}} {
+		p.Play(ClassicMove(rules.Move{X: cell[0], Y: cell[1], Symbol: p.Turn()}))
+	}
+	move, err := player.Move(context.Background(), p, rules.O)
+	if want := (rules.Move{X: 0, Y: 2, Symbol: rules.O}); err != nil || ClassicCell(move) != want {
+		t.Errorf("Expected %v, got %v %v", want, ClassicCell(move), err)
+	}
+	if p.Game.Rounds != 4 {
+		t.Errorf("Expected the position to be unchanged, got %d rounds", p.Game.Rounds)
+	}
+	if _, err := player.Move(context.Background(), p, rules.X); !errors.Is(err, ErrNotYourTurn) {
+		t.Errorf("Expected %v, got %v", ErrNotYourTurn, err)
+	}
+}
+
+// TestAIPlayer_Move_deadline tests the Move function of the player of a strategy with a deadline.
+// Checks if the search strategies return a legal move in time and if a slow strategy returns the error of the context.
+func TestAIPlayer_Move_deadline(t *testing.T) {
+	p, _ := NewCube(4, rules.X)
+	for _, name := range []string{"minimax", "mcts"} {
+		player, _ := NewPlayer(name, rand.New(rand.NewSource(1)))
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		start := time.Now()
+		move, err := player.Move(ctx, p, rules.X)
+		cancel()
+		if err != nil || !slices.Contains(p.Moves(), move) {
+			t.Errorf("Expected a legal move from %s, got %d %v", name, move, err)
+		}
+		if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+			t.Errorf("Expected %s to stop at the deadline, took %v", name, elapsed)
+		}
+	}
+
+	player := PlayerOf(slowStrategy{}, rand.New(rand.NewSource(1)))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := player.Move(ctx, p, rules.X); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
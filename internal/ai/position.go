@@ -0,0 +1,297 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ai contains the AI strategies of the game and their registry. The strategies play
+// any Position: the 3x3 game with its rule variants, Ultimate Tic-Tac-Toe or the 3D cube.
+package ai
+
+import (
+	"GoRythm/internal/cube"
+	"GoRythm/internal/rules"
+	"GoRythm/internal/ultimate"
+)
+
+// A Position is the state of a two-player game the strategies can play. The moves are
+// numbered by the position, the numbers of its moves are only valid on its own kind of game.
+type Position interface {
+	Turn() rules.Symbol   // The player to move
+	Moves() []int         // The legal moves of the player to move, none when the game is over
+	Play(move int) error  // Plays the move for the player to move
+	Clone() Position      // Returns an independent copy of the position
+	Winner() rules.Symbol // The winner of the game, None while playing or on a draw
+	Over() bool           // Whether the game is over
+	Evaluate() int        // The heuristic value of the position for the player to move
+	Depth() int           // The search depth keeping a full-width search fast on the position
+}
+
+// A Symmetric position knows the moves leading to symmetric positions, which have the same value.
+type Symmetric interface {
+	Position
+	SymmetricMoves(moves []int) [][]int // Groups the moves leading to symmetric positions, in the order of their first move
+}
+
+// A Classic struct is the Position of a 3x3 game with its rule variant.
+// Its moves are numbered by ClassicMove.
+type Classic struct {
+	Game *rules.Game
+}
+
+// NewClassic creates the position of a new 3x3 game with the rule variant.
+func NewClassic(first rules.Symbol, variant rules.Variant) *Classic {
+	return &Classic{Game: rules.NewGame(first, variant)}
+}
+
+// ClassicMove returns the number of the move of a 3x3 game: two numbers per cell, one for
+// each symbol placed.
+func ClassicMove(m rules.Move) int {
+	move := rules.Index(3, m.X, m.Y) * 2
+	if m.Symbol == rules.O {
+		move++
+	}
+	return move
+}
+
+// ClassicCell returns the move of a 3x3 game numbered by ClassicMove.
+func ClassicCell(move int) rules.Move {
+	c := rules.Coordinates(3, 2, move/2)
+	symbol := rules.X
+	if move%2 == 1 {
+		symbol = rules.O
+	}
+	return rules.Move{X: c[0], Y: c[1], Symbol: symbol}
+}
+
+// Turn returns the player to move.
+func (p *Classic) Turn() rules.Symbol {
+	return p.Game.Turn
+}
+
+// Moves returns the legal moves of the player to move.
+func (p *Classic) Moves() []int {
+	var moves []int
+	for _, m := range p.Game.Moves() {
+		moves = append(moves, ClassicMove(m))
+	}
+	return moves
+}
+
+// Play plays the move for the player to move.
+func (p *Classic) Play(move int) error {
+	_, err := p.Game.Play(ClassicCell(move))
+	return err
+}
+
+// Clone returns an independent copy of the position.
+func (p *Classic) Clone() Position {
+	return &Classic{Game: p.Game.Clone()}
+}
+
+// Winner returns the winner of the game with its rule variant.
+func (p *Classic) Winner() rules.Symbol {
+	winner, _ := p.Game.Winner()
+	return winner
+}
+
+// Over returns whether the game is over.
+func (p *Classic) Over() bool {
+	return p.Game.Over()
+}
+
+// Evaluate returns 0, the undecided 3x3 positions are valued as draws.
+func (p *Classic) Evaluate() int {
+	return 0
+}
+
+// Depth returns the search depth of the rule variant.
+func (p *Classic) Depth() int {
+	return p.Game.Variant.SearchDepth()
+}
+
+// SymmetricMoves groups the moves moved into each other by the symmetries leaving the position
+// unchanged. With a symbol limit, the order the symbols are removed in must be unchanged too.
+func (p *Classic) SymmetricMoves(moves []int) [][]int {
+	var symmetries []rules.Symmetry
+	for _, s := range p.Game.Board.Invariants() {
+		if s != rules.Identity && p.invariantQueues(s) {
+			symmetries = append(symmetries, s)
+		}
+	}
+	group := make(map[int]int, len(moves)) // The index of the group of each move, -1 until grouped
+	for _, move := range moves {
+		group[move] = -1
+	}
+	var groups [][]int
+	for _, move := range moves {
+		if group[move] >= 0 {
+			continue
+		}
+		group[move] = len(groups)
+		members := []int{move}
+		m := ClassicCell(move)
+		for _, s := range symmetries {
+			x, y := s.Apply(m.X, m.Y)
+			symmetric := ClassicMove(rules.Move{X: x, Y: y, Symbol: m.Symbol})
+			if g, ok := group[symmetric]; ok && g < 0 {
+				group[symmetric] = len(groups)
+				members = append(members, symmetric)
+			}
+		}
+		groups = append(groups, members)
+	}
+	return groups
+}
+
+// invariantQueues returns true if the symmetry leaves the order the symbols are removed in
+// unchanged, always without a symbol limit.
+func (p *Classic) invariantQueues(s rules.Symmetry) bool {
+	if p.Game.Variant.MaxSymbols == 0 {
+		return true
+	}
+	for _, player := range []rules.Symbol{rules.X, rules.O} {
+		queue, _ := p.Game.Queue(player)
+		for _, cell := range queue {
+			if x, y := s.Apply(cell[0], cell[1]); x != cell[0] || y != cell[1] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// An Ultimate struct is the Position of an Ultimate Tic-Tac-Toe game.
+// Its moves are numbered by UltimateMove.
+type Ultimate struct {
+	Game *ultimate.Game
+}
+
+// NewUltimate creates the position of a new Ultimate Tic-Tac-Toe game.
+func NewUltimate(first rules.Symbol) *Ultimate {
+	return &Ultimate{Game: ultimate.New(first)}
+}
+
+// UltimateMove returns the number of the move of an Ultimate game, its index on the whole board.
+func UltimateMove(m ultimate.Move) int {
+	return rules.Index(ultimate.Size, m.X, m.Y)
+}
+
+// UltimateCell returns the move of an Ultimate game numbered by UltimateMove.
+func UltimateCell(move int) ultimate.Move {
+	c := rules.Coordinates(ultimate.Size, 2, move)
+	return ultimate.Move{X: c[0], Y: c[1]}
+}
+
+// Turn returns the player to move.
+func (p *Ultimate) Turn() rules.Symbol {
+	return p.Game.Turn
+}
+
+// Moves returns the legal moves of the player to move.
+func (p *Ultimate) Moves() []int {
+	var moves []int
+	for _, m := range p.Game.Moves() {
+		moves = append(moves, UltimateMove(m))
+	}
+	return moves
+}
+
+// Play plays the move for the player to move.
+func (p *Ultimate) Play(move int) error {
+	return p.Game.Play(UltimateCell(move))
+}
+
+// Clone returns an independent copy of the position.
+func (p *Ultimate) Clone() Position {
+	clone := *p.Game
+	return &Ultimate{Game: &clone}
+}
+
+// Winner returns the player who aligned three won sub-boards.
+func (p *Ultimate) Winner() rules.Symbol {
+	winner, _ := p.Game.Winner()
+	return winner
+}
+
+// Over returns whether the game is over.
+func (p *Ultimate) Over() bool {
+	return p.Game.Over()
+}
+
+// Evaluate returns the heuristic value of the sub-boards and lines for the player to move.
+func (p *Ultimate) Evaluate() int {
+	return ultimate.Evaluate(p.Game, p.Game.Turn)
+}
+
+// Depth returns the default search depth of the Ultimate AI.
+func (p *Ultimate) Depth() int {
+	return ultimate.DefaultDepth
+}
+
+// A Cube struct is the Position of a 3D game. Its moves are the indexes of the cells.
+type Cube struct {
+	Game *cube.Game
+}
+
+// NewCube creates the position of a new 3D game on a cube of the given size.
+func NewCube(size int, first rules.Symbol) (*Cube, error) {
+	g, err := cube.New(size, first)
+	if err != nil {
+		return nil, err
+	}
+	return &Cube{Game: g}, nil
+}
+
+// CubeMove returns the number of the move of a 3D game on a cube of the given size.
+func CubeMove(size int, m cube.Move) int {
+	return rules.Index(size, m.X, m.Y, m.Z)
+}
+
+// CubeCell returns the move of a 3D game numbered by CubeMove.
+func CubeCell(size, move int) cube.Move {
+	c := rules.Coordinates(size, cube.Dimensions, move)
+	return cube.Move{X: c[0], Y: c[1], Z: c[2]}
+}
+
+// Turn returns the player to move.
+func (p *Cube) Turn() rules.Symbol {
+	return p.Game.Turn
+}
+
+// Moves returns the legal moves of the player to move.
+func (p *Cube) Moves() []int {
+	var moves []int
+	for _, m := range p.Game.Moves() {
+		moves = append(moves, CubeMove(p.Game.Size, m))
+	}
+	return moves
+}
+
+// Play plays the move for the player to move.
+func (p *Cube) Play(move int) error {
+	return p.Game.Play(CubeCell(p.Game.Size, move))
+}
+
+// Clone returns an independent copy of the position.
+func (p *Cube) Clone() Position {
+	return &Cube{Game: p.Game.Clone()}
+}
+
+// Winner returns the player who aligned a full line.
+func (p *Cube) Winner() rules.Symbol {
+	winner, _ := p.Game.Winner()
+	return winner
+}
+
+// Over returns whether the game is over.
+func (p *Cube) Over() bool {
+	return p.Game.Over()
+}
+
+// Evaluate returns the heuristic value of the open lines for the player to move.
+func (p *Cube) Evaluate() int {
+	return cube.Evaluate(p.Game, p.Game.Turn)
+}
+
+// Depth returns the default search depth of the 3D AI.
+func (p *Cube) Depth() int {
+	return cube.DefaultDepth
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package ai
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+const (
+	MinLevel = 1  // The weakest skill level, mostly random
+	MaxLevel = 10 // The strongest skill level, playing as the minimax strategy
+
+	maxBlunder = 0.5 // The probability of a random move at the weakest level
+	maxMistake = 0.4 // The probability of a suboptimal move at the weakest level
+)
+
+func init() {
+	for level := MinLevel; level <= MaxLevel; level++ {
+		Register(LevelName(level), func() Strategy { return Skilled{Level: level} })
+	}
+}
+
+// LevelName returns the name of the strategy registered for the skill level.
+func LevelName(level int) string {
+	return fmt.Sprintf("level%d", level)
+}
+
+// A Skilled strategy plays at a skill level from MinLevel to MaxLevel. Below the strongest level,
+// it searches less deep than the minimax strategy, sometimes plays a random move (a blunder) and
+// sometimes plays a suboptimal move (a mistake). Each level is stronger than the level below.
+type Skilled struct {
+	Level int
+}
+
+// level returns the level of the strategy within MinLevel and MaxLevel.
+func (s Skilled) level() int {
+	return min(max(s.Level, MinLevel), MaxLevel)
+}
+
+// weakness returns 1 at the weakest level, 0 at the strongest, decreasing linearly in between.
+func (s Skilled) weakness() float64 {
+	return float64(MaxLevel-s.level()) / float64(MaxLevel-MinLevel)
+}
+
+// Blunder returns the probability of a random move at the level.
+func (s Skilled) Blunder() float64 {
+	return maxBlunder * s.weakness()
+}
+
+// Mistake returns the probability of a suboptimal move at the level, when it is not a blunder.
+func (s Skilled) Mistake() float64 {
+	return maxMistake * s.weakness()
+}
+
+// SearchDepth returns the depth of the search at the level on the position, a share of the
+// depth of the position proportional to the level.
+func (s Skilled) SearchDepth(p Position) int {
+	return max(1, int(math.Ceil(float64(s.level()*p.Depth())/MaxLevel)))
+}
+
+// Move returns the move of the level.
+func (s Skilled) Move(p Position, r *rand.Rand) (int, error) {
+	return s.MoveContext(context.Background(), p, r)
+}
+
+// MoveContext returns the move of the level: a random move on a blunder, else one of the best
+// moves found by the search, or one of the next best moves on a mistake. With a deadline, the
+// search is deepened one move at a time as in the minimax strategy, and the scores of the
+// deepest search finished before the deadline are used.
+func (s Skilled) MoveContext(ctx context.Context, p Position, r *rand.Rand) (int, error) {
+	moves := p.Moves()
+	if len(moves) == 0 {
+		return 0, ErrNoMove
+	}
+	if r.Float64() < s.Blunder() {
+		return moves[r.Intn(len(moves))], nil
+	}
+	depth := s.SearchDepth(p)
+	var scores []int
+	if ctx.Done() == nil {
+		found, err := scoreMoves(ctx, p, moves, depth)
+		if err != nil {
+			return 0, err
+		}
+		scores = found
+	} else {
+		for d := 1; d <= depth; d++ {
+			found, err := scoreMoves(ctx, p, moves, d)
+			if err != nil {
+				break
+			}
+			scores = found
+		}
+		// Not even a single move could be searched
+		if scores == nil {
+			return moves[r.Intn(len(moves))], nil
+		}
+	}
+	best := math.MinInt
+	for _, score := range scores {
+		best = max(best, score)
+	}
+	target := best
+	if r.Float64() < s.Mistake() {
+		// The best score below the best one, if any
+		next := math.MinInt
+		for _, score := range scores {
+			if score < best {
+				next = max(next, score)
+			}
+		}
+		if next != math.MinInt {
+			target = next
+		}
+	}
+	var candidates []int
+	for i, score := range scores {
+		if score == target {
+			candidates = append(candidates, moves[i])
+		}
+	}
+	return candidates[r.Intn(len(candidates))], nil
+}
+
+// scoreMoves returns the exact value of each move for the player to move, searched down to the
+// given depth, or the error of the context if it is done before the end of the search.
+func scoreMoves(ctx context.Context, p Position, moves []int, depth int) ([]int, error) {
+	scores := make([]int, len(moves))
+	for i, move := range moves {
+		next := p.Clone()
+		if err := next.Play(move); err != nil {
+			return nil, err
+		}
+		scores[i] = -negamax(ctx, next, depth-1, math.MinInt+1, math.MaxInt)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return scores, nil
+}
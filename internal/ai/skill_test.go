@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package ai
+
+import (
+	"GoRythm/internal/cube"
+	"GoRythm/internal/rules"
+	"context"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestSkilled_parameters tests the Blunder, Mistake and SearchDepth functions.
+// Checks if the weakest level is the most random and if the strongest level plays as the minimax strategy.
+func TestSkilled_parameters(t *testing.T) {
+	p := NewClassic(rules.X, rules.Variant{})
+	weakest, strongest := Skilled{Level: MinLevel}, Skilled{Level: MaxLevel}
+	if weakest.Blunder() != maxBlunder || weakest.Mistake() != maxMistake || weakest.SearchDepth(p) != 1 {
+		t.Errorf("Expected the weakest level to blunder and search one move, got %v %v %d", weakest.Blunder(), weakest.Mistake(), weakest.SearchDepth(p))
+	}
+	if strongest.Blunder() != 0 || strongest.Mistake() != 0 || strongest.SearchDepth(p) != p.Depth() {
+		t.Errorf("Expected the strongest level to play perfectly, got %v %v %d", strongest.Blunder(), strongest.Mistake(), strongest.SearchDepth(p))
+	}
+	for level := MinLevel + 1; level <= MaxLevel; level++ {
+		s, below := Skilled{Level: level}, Skilled{Level: level - 1}
+		if s.Blunder() >= below.Blunder() || s.SearchDepth(p) < below.SearchDepth(p) {
+			t.Errorf("Expected level %d to be stronger than level %d", level, level-1)
+		}
+	}
+	if (Skilled{Level: 42}).SearchDepth(p) != p.Depth() {
+		t.Error("Expected the level to be clamped")
+	}
+}
+
+// TestSkilled_Move tests the Move function of the Skilled strategy.
+// Checks if the levels are registered, play legal moves, and if the strongest level wins immediately.
+func TestSkilled_Move(t *testing.T) {
+	p := NewClassic(rules.O, rules.Variant{})
+	// O can complete the first column
+	for _, cell := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}} {
+		p.Play(ClassicMove(rules.Move{X: cell[0], Y: cell[1], Symbol: p.Turn()}))
+	}
+	r := rand.New(rand.NewSource(1))
+	for level := MinLevel; level <= MaxLevel; level++ {
+		s, err := New(LevelName(level))
+		if err != nil {
+			t.Fatalf("Expected level %d to be registered, got %v", level, err)
+		}
+		if move, err := s.Move(p, r); err != nil || !slices.Contains(p.Moves(), move) {
+			t.Errorf("Expected a legal move at level %d, got %d %v", level, move, err)
+		}
+	}
+	for i := 0; i < 10; i++ {
+		move, _ := Skilled{Level: MaxLevel}.Move(p, r)
+		if want := (rules.Move{X: 0, Y: 2, Symbol: rules.O}); ClassicCell(move) != want {
+			t.Errorf("Expected %v, got %v", want, ClassicCell(move))
+		}
+	}
+}
+
+// TestSkilled_MoveContext_deadline tests the MoveContext function of the Skilled strategy with a
+// deadline shorter than its full search.
+// Checks if the strongest level still plays the winning move found by the shallow searches.
+func TestSkilled_MoveContext_deadline(t *testing.T) {
+	p, _ := NewCube(4, rules.X)
+	for _, m := range []cube.Move{{X: 0, Y: 0, Z: 2}, {X: 3, Y: 3, Z: 3}, {X: 1, Y: 0, Z: 2}, {X: 3, Y: 2, Z: 3}, {X: 2, Y: 0, Z: 2}, {X: 0, Y: 3, Z: 0}} {
+		p.Play(CubeMove(4, m))
+	}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		move, err := Skilled{Level: MaxLevel}.MoveContext(ctx, p, r)
+		cancel()
+		if want := (cube.Move{X: 3, Y: 0, Z: 2}); err != nil || CubeCell(4, move) != want {
+			t.Errorf("Expected the winning move %v, got %v %v", want, CubeCell(4, move), err)
+		}
+	}
+}
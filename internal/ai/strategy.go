@@ -0,0 +1,197 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package ai
+
+import (
+	"GoRythm/internal/rules"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+const (
+	winValue = 1 << 30 // The value of a won game, above any heuristic value
+)
+
+var (
+	ErrNoMove          = errors.New("no legal move")
+	ErrUnknownStrategy = errors.New("unknown strategy")
+)
+
+// A Strategy chooses the moves of the player to move. The random number generator is the only
+// source of randomness of the strategy, so that the games played with a seed can be replayed.
+type Strategy interface {
+	Move(p Position, r *rand.Rand) (int, error)
+}
+
+// A Factory creates a new instance of a strategy.
+type Factory func() Strategy
+
+// registry contains the factories of the strategies by name.
+var registry = map[string]Factory{}
+
+func init() {
+	Register("random", func() Strategy { return Random{} })
+	Register("minimax", func() Strategy { return Minimax{} })
+}
+
+// Register adds the strategy under the name. It panics if the name is already registered.
+func Register(name string, factory Factory) {
+	if _, ok := registry[name]; ok {
+		panic("ai: strategy registered twice: " + name)
+	}
+	registry[name] = factory
+}
+
+// New creates a new instance of the strategy registered under the name.
+func New(name string) (Strategy, error) {
+	factory, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownStrategy, name)
+	}
+	return factory(), nil
+}
+
+// Names returns the names of the registered strategies in alphabetical order.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// A Random strategy plays a uniformly random legal move.
+type Random struct{}
+
+// Move returns a random legal move.
+func (Random) Move(p Position, r *rand.Rand) (int, error) {
+	moves := p.Moves()
+	if len(moves) == 0 {
+		return 0, ErrNoMove
+	}
+	return moves[r.Intn(len(moves))], nil
+}
+
+// A Minimax strategy plays one of the best moves found by an alpha-beta search, chosen at random
+// so that the same game is not always played. The positions beyond the depth are valued by their
+// heuristic. The first moves of the classic game are taken from the opening book.
+type Minimax struct {
+	Depth  int  // The depth of the search, the depth of the position if 0
+	NoBook bool // Whether the opening book is not used
+}
+
+// Move returns one of the best moves.
+func (s Minimax) Move(p Position, r *rand.Rand) (int, error) {
+	return s.MoveContext(context.Background(), p, r)
+}
+
+// MoveContext returns one of the best moves. With a deadline, the search is deepened one move
+// at a time and one of the best moves of the deepest search finished before the deadline is
+// returned.
+func (s Minimax) MoveContext(ctx context.Context, p Position, r *rand.Rand) (int, error) {
+	moves := p.Moves()
+	if len(moves) == 0 {
+		return 0, ErrNoMove
+	}
+	if !s.NoBook {
+		if move, ok := bookMove(p, r); ok {
+			return move, nil
+		}
+	}
+	depth := s.Depth
+	if depth <= 0 {
+		depth = p.Depth()
+	}
+	if ctx.Done() == nil {
+		best, err := search(ctx, p, moves, depth)
+		if err != nil {
+			return 0, err
+		}
+		return best[r.Intn(len(best))], nil
+	}
+	best := moves
+	for d := 1; d <= depth; d++ {
+		found, err := search(ctx, p, moves, d)
+		if err != nil {
+			break
+		}
+		best = found
+	}
+	return best[r.Intn(len(best))], nil
+}
+
+// search returns the best moves found by an alpha-beta search of the given depth, or the error
+// of the context if it is done before the end of the search. Only one move of each group of
+// symmetric moves is searched.
+func search(ctx context.Context, p Position, moves []int, depth int) ([]int, error) {
+	var groups [][]int
+	if symmetric, ok := p.(Symmetric); ok {
+		groups = symmetric.SymmetricMoves(moves)
+	} else {
+		for _, move := range moves {
+			groups = append(groups, []int{move})
+		}
+	}
+	var best []int
+	alpha := math.MinInt + 1
+	for _, group := range groups {
+		next := p.Clone()
+		if err := next.Play(group[0]); err != nil {
+			return nil, err
+		}
+		// A move as good as the best one must be valued exactly
+		bound := alpha
+		if bound > math.MinInt+1 {
+			bound--
+		}
+		score := -negamax(ctx, next, depth-1, math.MinInt+1, -bound)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		switch {
+		case score > alpha:
+			alpha = score
+			best = append([]int(nil), group...)
+		case score == alpha:
+			best = append(best, group...)
+		}
+	}
+	return best, nil
+}
+
+// negamax returns the value of the position for the player to move, searched with alpha-beta
+// pruning down to the given depth. Faster wins and slower losses are better. It returns 0 as
+// soon as the context is done.
+func negamax(ctx context.Context, p Position, depth, alpha, beta int) int {
+	if ctx.Err() != nil {
+		return 0
+	}
+	if winner := p.Winner(); winner != rules.None {
+		if winner == p.Turn() {
+			return winValue + depth
+		}
+		return -winValue - depth
+	}
+	if p.Over() {
+		return 0
+	}
+	if depth <= 0 {
+		return p.Evaluate()
+	}
+	for _, move := range p.Moves() {
+		next := p.Clone()
+		next.Play(move)
+		score := -negamax(ctx, next, depth-1, -beta, -alpha)
+		if score >= beta {
+			return score
+		}
+		alpha = max(alpha, score)
+	}
+	return alpha
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"GoRythm/internal/ai"
+	"GoRythm/internal/cube"
+	"GoRythm/internal/rules"
+	"GoRythm/internal/ultimate"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// A Mode type represents the kind of game played.
+type Mode string
+
+const (
+	ClassicMode  Mode = "classic"  // The 3x3 game with its rule variants
+	UltimateMode Mode = "ultimate" // Ultimate Tic-Tac-Toe on a 9x9 board
+	CubeMode     Mode = "cube"     // The 3D game on a cube of 3 or 4 cells per side
+)
+
+var (
+	ErrInvalidGame = errors.New("invalid game")
+	ErrIllegalMove = errors.New("illegal move")
+	ErrGameOver    = errors.New("game over")
+)
+
+// A NewGame struct contains the settings of a game to create.
+type NewGame struct {
+	Mode  Mode          `json:"mode"`  // The kind of game, classic by default
+	Size  int           `json:"size"`  // The number of cells per side of the cube, 3 by default, the board size of the other modes
+	Rules rules.Variant `json:"rules"` // The rule variants of the classic game
+	First rules.Symbol  `json:"first"` // The first player, X by default
+}
+
+// A Move struct contains a move of any mode. The cells are indexed by column and row from the top
+// left, and by layer for the cube.
+type Move struct {
+	X      int          `json:"x"`                // The column of the cell
+	Y      int          `json:"y"`                // The row of the cell
+	Z      int          `json:"z"`                // The layer of the cube, 0 in the other modes
+	Symbol rules.Symbol `json:"symbol,omitempty"` // The symbol placed, only needed for a Wild move
+}
+
+// A LogEntry struct contains a move played in a game.
+type LogEntry struct {
+	Number int          `json:"number"` // The number of the move, from 1
+	Player rules.Symbol `json:"player"` // The player who played the move
+	Move   Move         `json:"move"`   // The move played
+}
+
+// A State struct contains the state of a game as returned by the API.
+type State struct {
+	ID     string        `json:"id"`               // The identifier of the game
+	Mode   Mode          `json:"mode"`             // The kind of game
+	Size   int           `json:"size"`             // The number of cells per side of the board
+	Rules  rules.Variant `json:"rules"`            // The rule variants of the classic game
+	Board  [][]string    `json:"board"`            // The rows of each layer from the top, "." for an empty cell, one layer except for the cube
+	Turn   rules.Symbol  `json:"turn"`             // The player to move
+	Moves  int           `json:"moves"`            // The number of moves played
+	Over   bool          `json:"over"`             // Whether the game is over
+	Winner rules.Symbol  `json:"winner,omitempty"` // The winner of the game, empty while playing or on a draw
+}
+
+// A game struct is a game hosted by the server. Its position is played by the AI strategies.
+type game struct {
+	id       string
+	mode     Mode
+	variant  rules.Variant
+	position ai.Position
+	log      []LogEntry
+
+	mu sync.Mutex // Protects the position and the log
+}
+
+// newGame creates the game of the settings with the identifier.
+func newGame(id string, settings NewGame) (*game, error) {
+	first := settings.First
+	if first == rules.None {
+		first = rules.X
+	}
+	if !first.Valid() {
+		return nil, fmt.Errorf("%w: %w: %q", ErrInvalidGame, rules.ErrInvalidPlayer, first)
+	}
+	g := &game{id: id, mode: settings.Mode, variant: settings.Rules.Normalize()}
+	if g.mode == "" {
+		g.mode = ClassicMode
+	}
+	if g.mode != ClassicMode && !g.variant.Classic() {
+		return nil, fmt.Errorf("%w: the rule variants are only played in the classic mode", ErrInvalidGame)
+	}
+	switch g.mode {
+	case ClassicMode:
+		if settings.Size != 0 && settings.Size != 3 {
+			return nil, fmt.Errorf("%w: the classic board has 3 cells per side", ErrInvalidGame)
+		}
+		g.position = ai.NewClassic(first, g.variant)
+	case UltimateMode:
+		if settings.Size != 0 && settings.Size != ultimate.Size {
+			return nil, fmt.Errorf("%w: the ultimate board has %d cells per side", ErrInvalidGame, ultimate.Size)
+		}
+		g.position = ai.NewUltimate(first)
+	case CubeMode:
+		size := settings.Size
+		if size == 0 {
+			size = cube.MinSize
+		}
+		p, err := ai.NewCube(size, first)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidGame, err)
+		}
+		g.position = p
+	default:
+		return nil, fmt.Errorf("%w: unknown mode %q", ErrInvalidGame, settings.Mode)
+	}
+	return g, nil
+}
+
+// size returns the number of cells per side of the board.
+func (g *game) size() int {
+	switch p := g.position.(type) {
+	case *ai.Ultimate:
+		return ultimate.Size
+	case *ai.Cube:
+		return p.Game.Size
+	}
+	return 3
+}
+
+// cell returns the symbol of the cell.
+func (g *game) cell(x, y, z int) rules.Symbol {
+	switch p := g.position.(type) {
+	case *ai.Classic:
+		return p.Game.Board[x][y]
+	case *ai.Ultimate:
+		return p.Game.Cell(x, y)
+	case *ai.Cube:
+		return p.Game.Cell(cube.Move{X: x, Y: y, Z: z})
+	}
+	return rules.None
+}
+
+// move returns the move numbered by the position.
+func (g *game) move(number int) Move {
+	switch p := g.position.(type) {
+	case *ai.Classic:
+		m := ai.ClassicCell(number)
+		return Move{X: m.X, Y: m.Y, Symbol: m.Symbol}
+	case *ai.Ultimate:
+		m := ai.UltimateCell(number)
+		return Move{X: m.X, Y: m.Y, Symbol: p.Turn()}
+	case *ai.Cube:
+		m := ai.CubeCell(p.Game.Size, number)
+		return Move{X: m.X, Y: m.Y, Z: m.Z, Symbol: p.Turn()}
+	}
+	return Move{}
+}
+
+// legal returns the legal moves of the player to move.
+func (g *game) legal() []Move {
+	moves := []Move{}
+	for _, number := range g.position.Moves() {
+		moves = append(moves, g.move(number))
+	}
+	return moves
+}
+
+// find returns the number of the legal move matching the move. The symbol can be left out
+// when a single symbol can be placed on the cell.
+func (g *game) find(m Move) (int, error) {
+	if g.position.Over() {
+		return 0, ErrGameOver
+	}
+	found := []int{}
+	for _, number := range g.position.Moves() {
+		legal := g.move(number)
+		if legal.X == m.X && legal.Y == m.Y && legal.Z == m.Z && (m.Symbol == rules.None || legal.Symbol == m.Symbol) {
+			found = append(found, number)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return 0, fmt.Errorf("%w: %v", ErrIllegalMove, m)
+	case 1:
+		return found[0], nil
+	}
+	return 0, fmt.Errorf("%w: the symbol of %v must be given", ErrIllegalMove, m)
+}
+
+// play plays the move numbered by the position and logs it.
+func (g *game) play(number int) (Move, error) {
+	player, m := g.position.Turn(), g.move(number)
+	if err := g.position.Play(number); err != nil {
+		return m, fmt.Errorf("%w: %w", ErrIllegalMove, err)
+	}
+	g.log = append(g.log, LogEntry{Number: len(g.log) + 1, Player: player, Move: m})
+	return m, nil
+}
+
+// state returns the state of the game.
+func (g *game) state() State {
+	size, layers := g.size(), 1
+	if g.mode == CubeMode {
+		layers = size
+	}
+	board := make([][]string, layers)
+	for z := range board {
+		for y := 0; y < size; y++ {
+			var row strings.Builder
+			for x := 0; x < size; x++ {
+				symbol := g.cell(x, y, z)
+				if symbol == rules.None {
+					symbol = "."
+				}
+				row.WriteString(string(symbol))
+			}
+			board[z] = append(board[z], row.String())
+		}
+	}
+	return State{
+		ID:     g.id,
+		Mode:   g.mode,
+		Size:   size,
+		Rules:  g.variant,
+		Board:  board,
+		Turn:   g.position.Turn(),
+		Moves:  len(g.log),
+		Over:   g.position.Over(),
+		Winner: g.position.Winner(),
+	}
+}
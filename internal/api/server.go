@@ -0,0 +1,287 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package api exposes the rules and the AI strategies of the game over a REST API with JSON
+// bodies, so that other programs can host games without the game client:
+//
+//	GET    /strategies         the names of the AI strategies
+//	POST   /games              creates a game from a NewGame, returns its State
+//	GET    /games              the States of the games
+//	GET    /games/{id}         the State of the game
+//	DELETE /games/{id}         deletes the game
+//	GET    /games/{id}/legal   the legal Moves of the player to move
+//	GET    /games/{id}/moves   the LogEntries of the moves played
+//	POST   /games/{id}/moves   plays a Move for the player to move, returns the State
+//	POST   /games/{id}/ai      asks an AI strategy for a move with an AIRequest, returns an AIMove
+//
+// The errors are returned as {"error": "<message>"} with the matching status code.
+package api
+
+import (
+	"GoRythm/internal/ai"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	MaxGames         = 1000             // The number of games hosted at the same time
+	DefaultStrategy  = "minimax"        // The strategy of the AI when none is given
+	DefaultTimeLimit = 2 * time.Second  // The time the AI has to choose its move when none is given
+	MaxTimeLimit     = 10 * time.Second // The longest time the AI can be given
+	maxBodySize      = 1 << 16          // The size of the largest request body, in bytes
+)
+
+var (
+	ErrTooManyGames = errors.New("too many games")
+	ErrUnknownGame  = errors.New("unknown game")
+	ErrBadRequest   = errors.New("bad request")
+)
+
+// An AIRequest struct contains the settings of a move asked to the AI.
+type AIRequest struct {
+	Strategy  string `json:"strategy"`  // The registered strategy playing, DefaultStrategy if empty
+	TimeLimit int    `json:"timeLimit"` // The time the strategy has in milliseconds, DefaultTimeLimit if 0
+	Play      bool   `json:"play"`      // Whether the move is played, otherwise it is only suggested
+}
+
+// An AIMove struct contains the move chosen by the AI.
+type AIMove struct {
+	Strategy string `json:"strategy"` // The strategy which chose the move
+	Move     Move   `json:"move"`     // The move chosen
+	State    State  `json:"state"`    // The state of the game, after the move if it is played
+}
+
+// A Server struct hosts the games of the API in memory.
+type Server struct {
+	games  map[string]*game
+	nextID int
+	random *rand.Rand // The source of the seeds of the AI moves
+
+	mu sync.Mutex // Protects the games, the next identifier and the random numbers
+}
+
+// NewServer creates a server without games. The AI moves draw their random numbers from the seed.
+func NewServer(seed int64) *Server {
+	return &Server{games: make(map[string]*game), nextID: 1, random: rand.New(rand.NewSource(seed))}
+}
+
+// Handler returns the HTTP handler of the API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /strategies", s.handleStrategies)
+	mux.HandleFunc("POST /games", s.handleCreate)
+	mux.HandleFunc("GET /games", s.handleList)
+	mux.HandleFunc("GET /games/{id}", s.withGame(s.handleState))
+	mux.HandleFunc("DELETE /games/{id}", s.handleDelete)
+	mux.HandleFunc("GET /games/{id}/legal", s.withGame(s.handleLegal))
+	mux.HandleFunc("GET /games/{id}/moves", s.withGame(s.handleLog))
+	mux.HandleFunc("POST /games/{id}/moves", s.withGame(s.handleMove))
+	mux.HandleFunc("POST /games/{id}/ai", s.withGame(s.handleAI))
+	return mux
+}
+
+// withGame returns a handler finding the game of the path, locked while the handler runs.
+func (s *Server) withGame(handler func(w http.ResponseWriter, r *http.Request, g *game)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		g, ok := s.games[r.PathValue("id")]
+		s.mu.Unlock()
+		if !ok {
+			writeError(w, fmt.Errorf("%w: %q", ErrUnknownGame, r.PathValue("id")))
+			return
+		}
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		handler(w, r, g)
+	}
+}
+
+// handleStrategies returns the names of the registered strategies.
+func (s *Server) handleStrategies(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, ai.Names())
+}
+
+// handleCreate creates a game from the settings of the body.
+func (s *Server) handleCreate(w http.ResponseWriter, r *http.Request) {
+	var settings NewGame
+	if err := readJSON(r, &settings); err != nil {
+		writeError(w, err)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.games) >= MaxGames {
+		writeError(w, ErrTooManyGames)
+		return
+	}
+	g, err := newGame(strconv.Itoa(s.nextID), settings)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.games[g.id] = g
+	s.nextID++
+	w.Header().Set("Location", "/games/"+g.id)
+	writeJSON(w, http.StatusCreated, g.state())
+}
+
+// handleList returns the states of the games, in the order of their creation.
+func (s *Server) handleList(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	games := make([]*game, 0, len(s.games))
+	for _, g := range s.games {
+		games = append(games, g)
+	}
+	s.mu.Unlock()
+	slices.SortFunc(games, func(a, b *game) int {
+		ia, _ := strconv.Atoi(a.id)
+		ib, _ := strconv.Atoi(b.id)
+		return ia - ib
+	})
+	states := make([]State, 0, len(games))
+	for _, g := range games {
+		g.mu.Lock()
+		states = append(states, g.state())
+		g.mu.Unlock()
+	}
+	writeJSON(w, http.StatusOK, states)
+}
+
+// handleDelete deletes the game.
+func (s *Server) handleDelete(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := r.PathValue("id")
+	if _, ok := s.games[id]; !ok {
+		writeError(w, fmt.Errorf("%w: %q", ErrUnknownGame, id))
+		return
+	}
+	delete(s.games, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleState returns the state of the game.
+func (s *Server) handleState(w http.ResponseWriter, r *http.Request, g *game) {
+	writeJSON(w, http.StatusOK, g.state())
+}
+
+// handleLegal returns the legal moves of the player to move.
+func (s *Server) handleLegal(w http.ResponseWriter, r *http.Request, g *game) {
+	writeJSON(w, http.StatusOK, g.legal())
+}
+
+// handleLog returns the moves played.
+func (s *Server) handleLog(w http.ResponseWriter, r *http.Request, g *game) {
+	writeJSON(w, http.StatusOK, append([]LogEntry{}, g.log...))
+}
+
+// handleMove plays the move of the body for the player to move.
+func (s *Server) handleMove(w http.ResponseWriter, r *http.Request, g *game) {
+	var m Move
+	if err := readJSON(r, &m); err != nil {
+		writeError(w, err)
+		return
+	}
+	number, err := g.find(m)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if _, err := g.play(number); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, g.state())
+}
+
+// handleAI asks a strategy for the move of the player to move, and plays it if asked.
+func (s *Server) handleAI(w http.ResponseWriter, r *http.Request, g *game) {
+	var request AIRequest
+	if err := readJSON(r, &request); err != nil {
+		writeError(w, err)
+		return
+	}
+	if request.Strategy == "" {
+		request.Strategy = DefaultStrategy
+	}
+	limit := time.Duration(request.TimeLimit) * time.Millisecond
+	if limit <= 0 {
+		limit = DefaultTimeLimit
+	}
+	limit = min(limit, MaxTimeLimit)
+	if g.position.Over() {
+		writeError(w, ErrGameOver)
+		return
+	}
+
+	s.mu.Lock()
+	random := rand.New(rand.NewSource(s.random.Int63()))
+	s.mu.Unlock()
+	player, err := ai.NewPlayer(request.Strategy, random)
+	if err != nil {
+		writeError(w, fmt.Errorf("%w: %w", ErrBadRequest, err))
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), limit)
+	defer cancel()
+	number, err := player.Move(ctx, g.position, g.position.Turn())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	move := g.move(number)
+	if request.Play {
+		if move, err = g.play(number); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, AIMove{Strategy: request.Strategy, Move: move, State: g.state()})
+}
+
+// readJSON decodes the body of the request into v. An empty body leaves v unchanged.
+func readJSON(r *http.Request, v any) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(nil, r.Body, maxBodySize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	return nil
+}
+
+// writeJSON writes the value as the JSON body of the response with the status code.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes the error with the status code matching it.
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, ErrBadRequest), errors.Is(err, ErrInvalidGame):
+		status = http.StatusBadRequest
+	case errors.Is(err, ErrUnknownGame):
+		status = http.StatusNotFound
+	case errors.Is(err, ErrGameOver):
+		status = http.StatusConflict
+	case errors.Is(err, ErrIllegalMove):
+		status = http.StatusUnprocessableEntity
+	case errors.Is(err, ErrTooManyGames):
+		status = http.StatusServiceUnavailable
+	case errors.Is(err, context.DeadlineExceeded):
+		status = http.StatusGatewayTimeout
+	}
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"GoRythm/internal/rules"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// startAPI starts the API on a test server closed at the end of the test.
+func startAPI(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(NewServer(1).Handler())
+	t.Cleanup(server.Close)
+	return server
+}
+
+// call sends the request with the body encoded in JSON, checks the status code of the response
+// and decodes its body into out, if not nil.
+func call(t *testing.T, server *httptest.Server, method, path string, body any, status int, out any) {
+	t.Helper()
+	var reader bytes.Buffer
+	if s, ok := body.(string); ok {
+		reader.WriteString(s)
+	} else if body != nil {
+		json.NewEncoder(&reader).Encode(body)
+	}
+	request, err := http.NewRequest(method, server.URL+path, &reader)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	response, err := server.Client().Do(request)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != status {
+		var e map[string]string
+		json.NewDecoder(response.Body).Decode(&e)
+		t.Fatalf("Expected the status %d for %s %s, got %d (%s)", status, method, path, response.StatusCode, e["error"])
+	}
+	if out != nil {
+		if err := json.NewDecoder(response.Body).Decode(out); err != nil {
+			t.Fatalf("Expected a JSON body, got %v", err)
+		}
+	}
+}
+
+// TestServer_classic tests the classic game of the API.
+// Checks if a game is created, played until X wins, and its state, legal moves and log returned.
+func TestServer_classic(t *testing.T) {
+	server := startAPI(t)
+	var state State
+	call(t, server, "POST", "/games", nil, http.StatusCreated, &state)
+	if state.ID != "1" || state.Mode != ClassicMode || state.Turn != rules.X || state.Size != 3 {
+		t.Errorf("Expected a classic game 1 started by X, got %+v", state)
+	}
+
+	moves := []Move{{X: 0, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 0}}
+	for _, m := range moves {
+		call(t, server, "POST", "/games/1/moves", m, http.StatusOK, &state)
+	}
+	if !state.Over || state.Winner != rules.X || state.Moves != 5 {
+		t.Errorf("Expected X to win after 5 moves, got %+v", state)
+	}
+	if want := []string{"XXX", "OO.", "..."}; strings.Join(state.Board[0], "/") != strings.Join(want, "/") {
+		t.Errorf("Expected the board %v, got %v", want, state.Board)
+	}
+	call(t, server, "POST", "/games/1/moves", Move{X: 2, Y: 2}, http.StatusConflict, nil)
+
+	var log []LogEntry
+	call(t, server, "GET", "/games/1/moves", nil, http.StatusOK, &log)
+	if len(log) != 5 || log[1] != (LogEntry{Number: 2, Player: rules.O, Move: Move{X: 0, Y: 1, Symbol: rules.O}}) {
+		t.Errorf("Expected the 5 moves in the log, got %+v", log)
+	}
+	var legal []Move
+	call(t, server, "GET", "/games/1/legal", nil, http.StatusOK, &legal)
+	if len(legal) != 0 {
+		t.Errorf("Expected no legal move after the game, got %v", legal)
+	}
+}
+
+// TestServer_modes tests the Ultimate and 3D games of the API.
+// Checks the size of the boards and the legal moves sent to a sub-board.
+func TestServer_modes(t *testing.T) {
+	server := startAPI(t)
+	var state State
+	call(t, server, "POST", "/games", NewGame{Mode: CubeMode, Size: 4, First: rules.O}, http.StatusCreated, &state)
+	if len(state.Board) != 4 || len(state.Board[3]) != 4 || state.Turn != rules.O {
+		t.Errorf("Expected 4 layers of 4 rows started by O, got %+v", state)
+	}
+	call(t, server, "POST", "/games/1/moves", Move{X: 1, Y: 2, Z: 3}, http.StatusOK, &state)
+	if state.Board[3][2] != ".O.." {
+		t.Errorf("Expected O on the third row of the last layer, got %v", state.Board[3])
+	}
+
+	call(t, server, "POST", "/games", NewGame{Mode: UltimateMode}, http.StatusCreated, &state)
+	call(t, server, "POST", "/games/2/moves", Move{X: 4, Y: 4}, http.StatusOK, nil)
+	var legal []Move
+	call(t, server, "GET", "/games/2/legal", nil, http.StatusOK, &legal)
+	if len(legal) != 8 {
+		t.Errorf("Expected the 8 empty cells of the central sub-board, got %v", legal)
+	}
+	for _, m := range legal {
+		if m.X/3 != 1 || m.Y/3 != 1 || m.Symbol != rules.O {
+			t.Errorf("Expected a move of O in the central sub-board, got %v", m)
+		}
+	}
+
+	var states []State
+	call(t, server, "GET", "/games", nil, http.StatusOK, &states)
+	if len(states) != 2 || states[0].Mode != CubeMode || states[1].Mode != UltimateMode {
+		t.Errorf("Expected the 3D and Ultimate games, got %+v", states)
+	}
+	call(t, server, "DELETE", "/games/1", nil, http.StatusNoContent, nil)
+	call(t, server, "GET", "/games/1", nil, http.StatusNotFound, nil)
+}
+
+// TestServer_ai tests the AI moves of the API.
+// Checks if minimax suggests the winning move without playing it, then plays it.
+func TestServer_ai(t *testing.T) {
+	server := startAPI(t)
+	call(t, server, "POST", "/games", nil, http.StatusCreated, nil)
+	for _, m := range []Move{{X: 0, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 0}, {X: 1, Y: 1}} {
+		call(t, server, "POST", "/games/1/moves", m, http.StatusOK, nil)
+	}
+
+	var move AIMove
+	call(t, server, "POST", "/games/1/ai", AIRequest{}, http.StatusOK, &move)
+	if move.Strategy != DefaultStrategy || move.Move != (Move{X: 2, Y: 0, Symbol: rules.X}) || move.State.Moves != 4 {
+		t.Errorf("Expected minimax to suggest the win of X, got %+v", move)
+	}
+	call(t, server, "POST", "/games/1/ai", AIRequest{Strategy: "minimax", TimeLimit: 500, Play: true}, http.StatusOK, &move)
+	if move.State.Winner != rules.X {
+		t.Errorf("Expected X to win with the move of the AI, got %+v", move.State)
+	}
+	call(t, server, "POST", "/games/1/ai", nil, http.StatusConflict, nil)
+
+	call(t, server, "POST", "/games", NewGame{Mode: UltimateMode}, http.StatusCreated, nil)
+	call(t, server, "POST", "/games/2/ai", AIRequest{Strategy: "mcts", Play: true}, http.StatusOK, &move)
+	if move.State.Moves != 1 || move.State.Turn != rules.O {
+		t.Errorf("Expected the move of X played, got %+v", move.State)
+	}
+	call(t, server, "POST", "/games/2/ai", AIRequest{Strategy: "chess"}, http.StatusBadRequest, nil)
+}
+
+// TestServer_errors tests the errors of the API.
+// Checks the status codes of the invalid games, the illegal moves and the invalid bodies.
+func TestServer_errors(t *testing.T) {
+	server := startAPI(t)
+	call(t, server, "POST", "/games", NewGame{Mode: "chess"}, http.StatusBadRequest, nil)
+	call(t, server, "POST", "/games", NewGame{Mode: CubeMode, Size: 5}, http.StatusBadRequest, nil)
+	call(t, server, "POST", "/games", NewGame{Mode: UltimateMode, Rules: rules.Variant{Misere: true}}, http.StatusBadRequest, nil)
+	call(t, server, "POST", "/games", `{"mode": "classic", "colour": "red"}`, http.StatusBadRequest, nil)
+	call(t, server, "GET", "/games/1", nil, http.StatusNotFound, nil)
+
+	call(t, server, "POST", "/games", NewGame{Rules: rules.Variant{Wild: true}}, http.StatusCreated, nil)
+	call(t, server, "POST", "/games/1/moves", Move{X: 1, Y: 1}, http.StatusUnprocessableEntity, nil)
+	call(t, server, "POST", "/games/1/moves", Move{X: 1, Y: 1, Symbol: rules.O}, http.StatusOK, nil)
+	call(t, server, "POST", "/games/1/moves", Move{X: 1, Y: 1, Symbol: rules.X}, http.StatusUnprocessableEntity, nil)
+	call(t, server, "POST", "/games/1/moves", Move{X: 3, Y: 0, Symbol: rules.X}, http.StatusUnprocessableEntity, nil)
+	call(t, server, "POST", "/games/1/moves", `{"x": "a"}`, http.StatusBadRequest, nil)
+}
@@ -2,22 +2,22 @@
 // SPDX-License-Identifier: Apache-2.0
 
 // Package audio provides audio player feature for playing music in the GoRythm
-// game as well as the beatmap struct, which contains the time and beat number
-// of each beat.
+// game. The beatmap of the music is provided by the rhythm package.
 package audio
 
 import (
 	"bytes"
 	_ "embed"
 	"fmt"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2/audio"
 	"github.com/hajimehoshi/ebiten/v2/audio/mp3"
 )
 
 const (
-	SampleRate = 44100
-	Volume     = 0.05
+	SampleRate    = 44100
+	DefaultVolume = 0.05 // The volume of the music until it is changed with SetVolume
 )
 
 //go:embed assets/audio/1.mp3
@@ -27,6 +27,7 @@ var mp3Data []byte
 type AudioPlayer struct {
 	context *audio.Context
 	player  *audio.Player
+	volume  float64
 }
 
 // NewAudioPlayer creates a new AudioPlayer instance with the given audio context.
@@ -48,17 +49,34 @@ func NewAudioPlayer(ctx *audio.Context) (*AudioPlayer, error) {
 	ap := &AudioPlayer{
 		context: ctx,
 		player:  player,
+		volume:  DefaultVolume,
 	}
 
 	return ap, nil
 }
 
-// Play plays the audio player with a fixed volume.
+// Play plays the audio player with the current volume.
 func (ap *AudioPlayer) Play() {
-	ap.player.SetVolume(Volume)
+	ap.player.SetVolume(ap.volume)
 	ap.player.Play()
 }
 
+// Pause pauses the audio player at its current position, Play resumes it.
+func (ap *AudioPlayer) Pause() {
+	ap.player.Pause()
+}
+
+// SetVolume changes the volume of the music (between 0 and 1), even while it is playing.
+func (ap *AudioPlayer) SetVolume(volume float64) {
+	ap.volume = volume
+	ap.player.SetVolume(volume)
+}
+
+// Volume returns the volume of the music.
+func (ap *AudioPlayer) Volume() float64 {
+	return ap.volume
+}
+
 // Restart stops the audio player, rewinds it to the beginning and pauses it.
 func (ap *AudioPlayer) Restart() error {
 	if err := ap.player.Rewind(); err != nil {
@@ -68,6 +86,11 @@ func (ap *AudioPlayer) Restart() error {
 	return nil
 }
 
+// SetPosition moves the audio player to the given position of the music.
+func (ap *AudioPlayer) SetPosition(position time.Duration) error {
+	return ap.player.SetPosition(position)
+}
+
 // Close closes the audio player.
 func (ap *AudioPlayer) Close() error {
 	return ap.player.Close()
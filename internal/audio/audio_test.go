@@ -5,6 +5,7 @@ package audio
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2/audio"
 )
@@ -63,6 +64,22 @@ func TestAudioPlayer_Restart(t *testing.T) {
 	}
 }
 
+// TestAudioPlayer_SetPosition tests the SetPosition method of the AudioPlayer.
+// Checks if the player is moved to the given position.
+func TestAudioPlayer_SetPosition(t *testing.T) {
+	ap, err := NewAudioPlayer(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if err := ap.SetPosition(2 * time.Second); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if position := ap.player.Position(); position != 2*time.Second {
+		t.Fatalf("Expected position 2s, got %v", position)
+	}
+}
+
 // TestAudioPlayer_Close tests the Close method of the AudioPlayer.
 // Checks if the player is closed without any errors.
 func TestAudioPlayer_Close(t *testing.T) {
@@ -76,3 +93,18 @@ func TestAudioPlayer_Close(t *testing.T) {
 		t.Fatalf("Expected no error, got %v", err)
 	}
 }
+
+// TestAudioPlayer_SetVolume tests the SetVolume method of the AudioPlayer.
+// Checks if the volume is applied to the playing player.
+func TestAudioPlayer_SetVolume(t *testing.T) {
+	ap, err := NewAudioPlayer(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	ap.Play()
+	ap.SetVolume(0.5)
+	if ap.Volume() != 0.5 || ap.player.Volume() != 0.5 {
+		t.Errorf("Expected volume 0.5, got %v and %v", ap.Volume(), ap.player.Volume())
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package clock provides the chess clock of the timed games. Each player has a bank of time
+// running during its turns, and receives an increment after each move. A player whose bank
+// is empty loses on time.
+package clock
+
+import (
+	"GoRythm/internal/rules"
+	"fmt"
+	"time"
+)
+
+// A Control struct contains the time control of a game.
+type Control struct {
+	Bank      time.Duration // The initial time of each player
+	Increment time.Duration // The time added to a player after each of its moves
+}
+
+// The time controls that can be chosen in the menu, the first one disables the clock
+var Presets = []Control{
+	{},
+	{Bank: 10 * time.Second, Increment: time.Second},
+	{Bank: 30 * time.Second, Increment: 2 * time.Second},
+	{Bank: time.Minute},
+	{Bank: 3 * time.Minute, Increment: 5 * time.Second},
+}
+
+// Enabled returns whether the time control has a clock.
+func (c Control) Enabled() bool {
+	return c.Bank > 0
+}
+
+// String returns the name of the time control, as "0:30 + 2s".
+func (c Control) String() string {
+	if !c.Enabled() {
+		return "No clock"
+	}
+	if c.Increment == 0 {
+		return Format(c.Bank)
+	}
+	return fmt.Sprintf("%s + %ds", Format(c.Bank), int(c.Increment.Seconds()))
+}
+
+// Format returns a duration as minutes and seconds, rounded up to the second.
+func Format(d time.Duration) string {
+	seconds := int((d + time.Second - 1) / time.Second)
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+// A Clock struct contains the remaining time of both players.
+type Clock struct {
+	control   Control
+	remaining map[rules.Symbol]time.Duration
+	running   rules.Symbol // The player whose time is running, None before the start
+	since     time.Time    // The last time the running time was counted
+	paused    bool
+}
+
+// New creates a new clock with the given time control, stopped.
+func New(control Control) *Clock {
+	return &Clock{
+		control: control,
+		remaining: map[rules.Symbol]time.Duration{
+			rules.X: control.Bank,
+			rules.O: control.Bank,
+		},
+	}
+}
+
+// Start starts the time of the given player.
+func (c *Clock) Start(player rules.Symbol, now time.Time) {
+	c.running = player
+	c.since = now
+	c.paused = false
+}
+
+// update counts the time elapsed since the last update for the running player.
+func (c *Clock) update(now time.Time) {
+	if c.running == rules.None || c.paused {
+		return
+	}
+	c.remaining[c.running] -= now.Sub(c.since)
+	if c.remaining[c.running] < 0 {
+		c.remaining[c.running] = 0
+	}
+	c.since = now
+}
+
+// Switch ends the turn of the running player, which receives the increment unless it is
+// out of time, and starts the time of its opponent.
+func (c *Clock) Switch(now time.Time) {
+	if c.running == rules.None {
+		return
+	}
+	c.update(now)
+	if c.remaining[c.running] > 0 {
+		c.remaining[c.running] += c.control.Increment
+	}
+	c.running = c.running.Opponent()
+}
+
+// Pause stops the time of the running player until Resume is called.
+func (c *Clock) Pause(now time.Time) {
+	c.update(now)
+	c.paused = true
+}
+
+// Resume restarts the time of the running player after a pause.
+func (c *Clock) Resume(now time.Time) {
+	if c.paused {
+		c.paused = false
+		c.since = now
+	}
+}
+
+// Remaining returns the remaining time of the player.
+func (c *Clock) Remaining(player rules.Symbol, now time.Time) time.Duration {
+	c.update(now)
+	return c.remaining[player]
+}
+
+// Stop stops the time of both players, at the end of the game.
+func (c *Clock) Stop(now time.Time) {
+	c.update(now)
+	c.running = rules.None
+}
+
+// Running returns the player whose time is running, None before the start.
+func (c *Clock) Running() rules.Symbol {
+	return c.running
+}
+
+// Flagged returns the player out of time, or None.
+func (c *Clock) Flagged(now time.Time) rules.Symbol {
+	c.update(now)
+	for _, player := range []rules.Symbol{rules.X, rules.O} {
+		if c.remaining[player] <= 0 {
+			return player
+		}
+	}
+	return rules.None
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package clock
+
+import (
+	"GoRythm/internal/rules"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// TestClock_Switch tests the Switch function.
+// Checks if only the time of the running player decreases and if the increment is added after a move.
+func TestClock_Switch(t *testing.T) {
+	c := New(Control{Bank: 10 * time.Second, Increment: 2 * time.Second})
+	c.Start(rules.X, start)
+	c.Switch(start.Add(3 * time.Second))
+	if remaining := c.Remaining(rules.X, start.Add(3*time.Second)); remaining != 9*time.Second {
+		t.Errorf("Expected 9s for X, got %v", remaining)
+	}
+	if c.Running() != rules.O {
+		t.Errorf("Expected the time of O to run, got %s", c.Running())
+	}
+	if remaining := c.Remaining(rules.O, start.Add(4*time.Second)); remaining != 9*time.Second {
+		t.Errorf("Expected 9s for O, got %v", remaining)
+	}
+	if remaining := c.Remaining(rules.X, start.Add(4*time.Second)); remaining != 9*time.Second {
+		t.Errorf("Expected the time of X to be stopped at 9s, got %v", remaining)
+	}
+}
+
+// TestClock_Flagged tests the Flagged function.
+// Checks if the running player loses on time without increment.
+func TestClock_Flagged(t *testing.T) {
+	c := New(Control{Bank: 5 * time.Second, Increment: time.Second})
+	c.Start(rules.O, start)
+	if flagged := c.Flagged(start.Add(4 * time.Second)); flagged != rules.None {
+		t.Errorf("Expected nobody out of time, got %s", flagged)
+	}
+	if flagged := c.Flagged(start.Add(6 * time.Second)); flagged != rules.O {
+		t.Errorf("Expected O out of time, got %s", flagged)
+	}
+	c.Switch(start.Add(6 * time.Second))
+	if remaining := c.Remaining(rules.O, start.Add(6*time.Second)); remaining != 0 {
+		t.Errorf("Expected no increment out of time, got %v", remaining)
+	}
+}
+
+// TestClock_Pause tests the Pause and Resume functions.
+// Checks if the time does not run during a pause.
+func TestClock_Pause(t *testing.T) {
+	c := New(Control{Bank: 10 * time.Second})
+	c.Start(rules.X, start)
+	c.Pause(start.Add(2 * time.Second))
+	c.Resume(start.Add(60 * time.Second))
+	if remaining := c.Remaining(rules.X, start.Add(61*time.Second)); remaining != 7*time.Second {
+		t.Errorf("Expected 7s for X, got %v", remaining)
+	}
+}
+
+// TestControl_String tests the String and Format functions.
+// Checks the names of the time controls.
+func TestControl_String(t *testing.T) {
+	tests := map[Control]string{
+		{}:                  "No clock",
+		{Bank: time.Minute}: "1:00",
+		{Bank: 30 * time.Second, Increment: 2 * time.Second}: "0:30 + 2s",
+	}
+	for control, expected := range tests {
+		if name := control.String(); name != expected {
+			t.Errorf("Expected %s, got %s", expected, name)
+		}
+	}
+	if formatted := Format(1500 * time.Millisecond); formatted != "0:02" {
+		t.Errorf("Expected 0:02, got %s", formatted)
+	}
+}
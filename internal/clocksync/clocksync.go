@@ -0,0 +1,155 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package clocksync provides an NTP-style synchronisation between the clock of a client
+// and the clock of a server, so both agree on the music time used to judge the hits.
+//
+// The client sends its time t0, the server answers with its reception time t1 and its
+// sending time t2, and the client notes the reception time t3. Each exchange gives a
+// sample of the clock offset and of the round-trip delay. The estimator keeps a window
+// of samples, trusts the ones with the lowest delay and follows the drift between the
+// clocks over time.
+package clocksync
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultWindow = 16 // The default number of samples kept by an estimator
+	minDriftSpan  = 4 * time.Second
+	minDriftCount = 4
+	maxDrift      = 0.001 // The maximum drift followed between two clocks (in seconds per second)
+)
+
+// A Sample struct contains the clock offset and round-trip delay measured by one exchange.
+type Sample struct {
+	Offset time.Duration // The server clock minus the client clock
+	Delay  time.Duration // The round-trip delay, without the server processing time
+	At     time.Time     // The client time the sample was measured
+}
+
+// NewSample computes the sample of an exchange from the client send time t0, the server
+// receive time t1, the server send time t2 and the client receive time t3.
+func NewSample(t0, t1, t2, t3 time.Time) Sample {
+	return Sample{
+		Offset: (t1.Sub(t0) + t2.Sub(t3)) / 2,
+		Delay:  t3.Sub(t0) - t2.Sub(t1),
+		At:     t3,
+	}
+}
+
+// An Estimator struct estimates the offset and delay between a client and a server from
+// the latest samples. It is safe for concurrent use.
+type Estimator struct {
+	mu      sync.Mutex
+	window  int
+	samples []Sample
+	offset  time.Duration // The offset of the best sample
+	ref     time.Time     // The client time of the best sample
+	drift   float64       // The drift of the offset (in seconds per second)
+	delay   time.Duration // The delay of the best sample
+}
+
+// NewEstimator creates a new estimator keeping the given number of samples.
+func NewEstimator(window int) *Estimator {
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	return &Estimator{window: window}
+}
+
+// Add adds a sample and updates the estimation. The samples with a negative delay,
+// caused by a clock going backward during the exchange, are ignored.
+func (e *Estimator) Add(s Sample) {
+	if s.Delay < 0 {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.samples = append(e.samples, s)
+	if len(e.samples) > e.window {
+		e.samples = e.samples[len(e.samples)-e.window:]
+	}
+
+	// The sample with the lowest delay has the lowest error on the offset
+	best := e.samples[0]
+	for _, sample := range e.samples[1:] {
+		if sample.Delay < best.Delay {
+			best = sample
+		}
+	}
+	e.offset = best.Offset
+	e.ref = best.At
+	e.delay = best.Delay
+	e.drift = e.estimateDrift(best.Delay)
+}
+
+// estimateDrift returns the slope of the offsets of the good samples over time, computed by
+// least squares. It returns 0 when the samples do not span enough time.
+func (e *Estimator) estimateDrift(bestDelay time.Duration) float64 {
+	var good []Sample
+	for _, sample := range e.samples {
+		if sample.Delay <= 2*bestDelay+time.Millisecond {
+			good = append(good, sample)
+		}
+	}
+	if len(good) < minDriftCount || good[len(good)-1].At.Sub(good[0].At) < minDriftSpan {
+		return 0
+	}
+
+	var sumT, sumO, sumTT, sumTO float64
+	for _, sample := range good {
+		t := sample.At.Sub(good[0].At).Seconds()
+		o := sample.Offset.Seconds()
+		sumT += t
+		sumO += o
+		sumTT += t * t
+		sumTO += t * o
+	}
+	n := float64(len(good))
+	denominator := n*sumTT - sumT*sumT
+	if denominator == 0 {
+		return 0
+	}
+	drift := (n*sumTO - sumT*sumO) / denominator
+	return math.Max(-maxDrift, math.Min(maxDrift, drift))
+}
+
+// Synced returns true if at least one sample was added.
+func (e *Estimator) Synced() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return len(e.samples) > 0
+}
+
+// Offset returns the estimated offset of the server clock at the given client time.
+func (e *Estimator) Offset(at time.Time) time.Duration {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.ref.IsZero() {
+		return 0
+	}
+	return e.offset + time.Duration(e.drift*float64(at.Sub(e.ref)))
+}
+
+// Delay returns the round-trip delay of the best sample.
+func (e *Estimator) Delay() time.Duration {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.delay
+}
+
+// ServerTime converts a client time to the server clock.
+func (e *Estimator) ServerTime(local time.Time) time.Time {
+	return local.Add(e.Offset(local))
+}
+
+// LocalTime converts a server time to the client clock.
+func (e *Estimator) LocalTime(server time.Time) time.Time {
+	// The offset changes slowly, so it is computed at an approximation of the client time
+	approx := server.Add(-e.Offset(server))
+	return server.Add(-e.Offset(approx))
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package clocksync
+
+import (
+	"testing"
+	"time"
+)
+
+var epoch = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// exchange simulates an exchange started at the client time t0 with a server clock ahead by
+// offset, a one-way delay for each direction and a server processing time of 1 ms.
+func exchange(t0 time.Time, offset, up, down time.Duration) Sample {
+	t1 := t0.Add(offset + up)
+	t2 := t1.Add(time.Millisecond)
+	t3 := t2.Add(-offset + down)
+	return NewSample(t0, t1, t2, t3)
+}
+
+// abs returns the absolute value of a duration.
+func abs(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// TestNewSample tests the NewSample function.
+// Checks if the offset and delay are exact with symmetric delays.
+func TestNewSample(t *testing.T) {
+	s := exchange(epoch, 250*time.Millisecond, 20*time.Millisecond, 20*time.Millisecond)
+	if s.Offset != 250*time.Millisecond {
+		t.Errorf("Expected offset 250ms, got %v", s.Offset)
+	}
+	if s.Delay != 40*time.Millisecond {
+		t.Errorf("Expected delay 40ms, got %v", s.Delay)
+	}
+}
+
+// TestEstimator_BestSample tests the Add function with noisy delays.
+// Checks if the estimation uses the sample with the lowest delay.
+func TestEstimator_BestSample(t *testing.T) {
+	e := NewEstimator(DefaultWindow)
+	if e.Synced() {
+		t.Fatal("Expected the estimator not to be synced without samples")
+	}
+	offset := -80 * time.Millisecond
+	e.Add(exchange(epoch, offset, 200*time.Millisecond, 10*time.Millisecond))
+	e.Add(exchange(epoch.Add(time.Second), offset, 5*time.Millisecond, 5*time.Millisecond))
+	e.Add(exchange(epoch.Add(2*time.Second), offset, 10*time.Millisecond, 150*time.Millisecond))
+
+	if !e.Synced() {
+		t.Fatal("Expected the estimator to be synced")
+	}
+	if got := e.Offset(epoch.Add(2 * time.Second)); got != offset {
+		t.Errorf("Expected offset %v, got %v", offset, got)
+	}
+	if got := e.Delay(); got != 10*time.Millisecond {
+		t.Errorf("Expected delay 10ms, got %v", got)
+	}
+}
+
+// TestEstimator_Drift tests the drift correction of the estimator.
+// Checks if the offset follows a server clock running faster than the client clock.
+func TestEstimator_Drift(t *testing.T) {
+	e := NewEstimator(DefaultWindow)
+	drift := 0.0005 // 0.5 ms per second
+	for i := 0; i < 10; i++ {
+		at := epoch.Add(time.Duration(i) * time.Second)
+		offset := time.Duration(drift * float64(time.Duration(i)*time.Second))
+		e.Add(exchange(at, offset, 10*time.Millisecond, 10*time.Millisecond))
+	}
+
+	// 20 seconds after the first sample the offset should be about 10 ms
+	at := epoch.Add(20 * time.Second)
+	expected := 10 * time.Millisecond
+	if got := e.Offset(at); abs(got-expected) > 500*time.Microsecond {
+		t.Errorf("Expected offset about %v, got %v", expected, got)
+	}
+}
+
+// TestEstimator_Conversions tests the ServerTime and LocalTime functions.
+// Checks if a time converted to the server clock and back is unchanged.
+func TestEstimator_Conversions(t *testing.T) {
+	e := NewEstimator(DefaultWindow)
+	e.Add(exchange(epoch, 3*time.Second, 10*time.Millisecond, 10*time.Millisecond))
+
+	local := epoch.Add(time.Minute)
+	server := e.ServerTime(local)
+	if server.Sub(local) != 3*time.Second {
+		t.Errorf("Expected the server time 3s ahead, got %v", server.Sub(local))
+	}
+	if back := e.LocalTime(server); !back.Equal(local) {
+		t.Errorf("Expected %v, got %v", local, back)
+	}
+}
+
+// TestEstimator_NegativeDelay tests the Add function with an invalid sample.
+// Checks if a sample with a negative delay is ignored.
+func TestEstimator_NegativeDelay(t *testing.T) {
+	e := NewEstimator(DefaultWindow)
+	e.Add(Sample{Offset: time.Second, Delay: -time.Millisecond, At: epoch})
+	if e.Synced() {
+		t.Error("Expected the sample to be ignored")
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package clocksync
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	DefaultTolerance = 150 * time.Millisecond // The default tolerance on the reported hit times
+	MaxDelay         = time.Second            // The maximum round-trip delay accepted for a client
+)
+
+var (
+	ErrHitInFuture = errors.New("the hit time is after the reception of the move")
+	ErrHitTooOld   = errors.New("the hit time is older than the round-trip delay")
+)
+
+// A Timeline struct contains the shared music timeline of a match on the server clock.
+type Timeline struct {
+	Start     time.Time     // The server time the music starts
+	Tolerance time.Duration // The tolerance on the reported hit times
+}
+
+// NewTimeline creates a new timeline starting at the given server time with the default tolerance.
+func NewTimeline(start time.Time) Timeline {
+	return Timeline{Start: start, Tolerance: DefaultTolerance}
+}
+
+// SongTime returns the music time (in seconds) at the given server time.
+func (tl Timeline) SongTime(at time.Time) float64 {
+	return at.Sub(tl.Start).Seconds()
+}
+
+// Validate checks a hit time (in seconds of music) reported by a client against the server
+// time the move was received. The hit must have happened before its reception, and not
+// earlier than the round-trip delay of the client, with the tolerance of the timeline.
+// The delay is capped to MaxDelay so a client cannot claim old hits by lying about it.
+func (tl Timeline) Validate(hitTime float64, received time.Time, delay time.Duration) error {
+	delay = min(max(delay, 0), MaxDelay)
+	receivedTime := tl.SongTime(received)
+	tolerance := tl.Tolerance.Seconds()
+	if hitTime > receivedTime+tolerance {
+		return ErrHitInFuture
+	}
+	if hitTime < receivedTime-delay.Seconds()-tolerance {
+		return ErrHitTooOld
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package clocksync
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestTimeline_SongTime tests the SongTime function.
+// Checks if the music time is counted from the start of the timeline.
+func TestTimeline_SongTime(t *testing.T) {
+	tl := NewTimeline(epoch)
+	if got := tl.SongTime(epoch.Add(1500 * time.Millisecond)); got != 1.5 {
+		t.Errorf("Expected 1.5, got %v", got)
+	}
+}
+
+// TestTimeline_Validate tests the Validate function.
+// Checks if the hits are accepted within the delay and tolerance, and refused otherwise.
+func TestTimeline_Validate(t *testing.T) {
+	tl := NewTimeline(epoch)
+	received := epoch.Add(10 * time.Second)
+	delay := 100 * time.Millisecond
+
+	tests := []struct {
+		hitTime  float64
+		expected error
+	}{
+		{10.0, nil},
+		{9.95, nil},
+		{9.8, nil},
+		{10.1, nil},
+		{10.5, ErrHitInFuture},
+		{9.5, ErrHitTooOld},
+	}
+	for _, test := range tests {
+		if err := tl.Validate(test.hitTime, received, delay); !errors.Is(err, test.expected) {
+			t.Errorf("Expected %v for hit time %v, got %v", test.expected, test.hitTime, err)
+		}
+	}
+}
+
+// TestTimeline_ValidateMaxDelay tests the Validate function with a huge delay.
+// Checks if the delay is capped so old hits are still refused.
+func TestTimeline_ValidateMaxDelay(t *testing.T) {
+	tl := NewTimeline(epoch)
+	received := epoch.Add(10 * time.Second)
+	if err := tl.Validate(5.0, received, time.Minute); !errors.Is(err, ErrHitTooOld) {
+		t.Errorf("Expected ErrHitTooOld, got %v", err)
+	}
+}
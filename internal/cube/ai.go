@@ -0,0 +1,44 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package cube
+
+import (
+	"GoRythm/internal/rules"
+	"math"
+)
+
+const (
+	DefaultDepth = 3 // The default search depth of the AI, in moves
+
+	winValue = 1000000 // The value of a won game
+)
+
+// Evaluate returns the heuristic value of the game for the player: each line owned by only one
+// player is worth ten times more for each of its symbols, positive for the player and negative
+// for its opponent.
+func Evaluate(g *Game, player rules.Symbol) int {
+	score := 0
+	for _, line := range g.lines {
+		mine, theirs := 0, 0
+		for _, index := range line {
+			switch g.Cells[index] {
+			case player:
+				mine++
+			case player.Opponent():
+				theirs++
+			}
+		}
+		switch {
+		case mine == g.Size:
+			return winValue
+		case theirs == g.Size:
+			return -winValue
+		case theirs == 0 && mine > 0:
+			score += int(math.Pow10(mine - 1))
+		case mine == 0 && theirs > 0:
+			score -= int(math.Pow10(theirs - 1))
+		}
+	}
+	return score
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cube contains the rules of the three-dimensional Tic-Tac-Toe, played on a cube of
+// 3x3x3 or 4x4x4 cells shown as stacked layers. A player wins by aligning as many symbols as
+// the size of the cube along any row, column, pillar or diagonal.
+package cube
+
+import (
+	"GoRythm/internal/rules"
+	"errors"
+	"fmt"
+)
+
+const (
+	Dimensions = 3 // The number of dimensions of the board
+	MinSize    = 3 // The smallest cube
+	MaxSize    = 4 // The largest cube
+)
+
+var (
+	ErrInvalidSize = fmt.Errorf("invalid size, must be between %d and %d", MinSize, MaxSize)
+	ErrIllegalMove = errors.New("illegal move")
+	ErrGameOver    = errors.New("game over")
+)
+
+// A Move struct contains the position of a cell: X and Y in its layer and Z the layer.
+type Move struct {
+	X, Y, Z int
+}
+
+// A Game struct contains the state of a 3D game.
+type Game struct {
+	Size   int            // The number of cells on each side of the cube
+	Cells  []rules.Symbol // The cells, indexed by rules.Index(Size, X, Y, Z)
+	Turn   rules.Symbol   // The player to move
+	Rounds int            // The number of moves played
+	lines  [][]int        // The winning lines of the cube
+}
+
+// New creates a new game on a cube of the given size, started by the given player.
+func New(size int, first rules.Symbol) (*Game, error) {
+	if size < MinSize || size > MaxSize {
+		return nil, ErrInvalidSize
+	}
+	return &Game{
+		Size:  size,
+		Cells: make([]rules.Symbol, size*size*size),
+		Turn:  first,
+		lines: rules.Lines(size, Dimensions),
+	}, nil
+}
+
+// Clone returns an independent copy of the game.
+func (g *Game) Clone() *Game {
+	clone := *g
+	clone.Cells = append([]rules.Symbol(nil), g.Cells...)
+	return &clone
+}
+
+// index returns the index of the cell of the move.
+func (g *Game) index(m Move) int {
+	return rules.Index(g.Size, m.X, m.Y, m.Z)
+}
+
+// move returns the move of the cell at the index.
+func (g *Game) move(index int) Move {
+	c := rules.Coordinates(g.Size, Dimensions, index)
+	return Move{c[0], c[1], c[2]}
+}
+
+// Cell returns the symbol of the cell.
+func (g *Game) Cell(m Move) rules.Symbol {
+	return g.Cells[g.index(m)]
+}
+
+// Legal returns true if the move is on the cube and its cell is empty.
+func (g *Game) Legal(m Move) bool {
+	inBounds := func(c int) bool { return c >= 0 && c < g.Size }
+	return inBounds(m.X) && inBounds(m.Y) && inBounds(m.Z) && g.Cell(m) == rules.None
+}
+
+// Moves returns the legal moves of the current player, layer after layer.
+func (g *Game) Moves() []Move {
+	if g.Over() {
+		return nil
+	}
+	var moves []Move
+	for index, symbol := range g.Cells {
+		if symbol == rules.None {
+			moves = append(moves, g.move(index))
+		}
+	}
+	return moves
+}
+
+// Play plays the move for the current player and switches the player.
+func (g *Game) Play(m Move) error {
+	if g.Over() {
+		return ErrGameOver
+	}
+	if !g.Legal(m) {
+		return ErrIllegalMove
+	}
+	g.Cells[g.index(m)] = g.Turn
+	g.Turn = g.Turn.Opponent()
+	g.Rounds++
+	return nil
+}
+
+// Winner returns the player who aligned a full line and the cells of the line, or None and nil.
+func (g *Game) Winner() (rules.Symbol, []Move) {
+	for _, line := range g.lines {
+		first := g.Cells[line[0]]
+		if first == rules.None {
+			continue
+		}
+		aligned := true
+		for _, index := range line[1:] {
+			if g.Cells[index] != first {
+				aligned = false
+				break
+			}
+		}
+		if aligned {
+			moves := make([]Move, len(line))
+			for i, index := range line {
+				moves[i] = g.move(index)
+			}
+			return first, moves
+		}
+	}
+	return rules.None, nil
+}
+
+// Full returns true if there is no empty cell left.
+func (g *Game) Full() bool {
+	return g.Rounds == len(g.Cells)
+}
+
+// Over returns true if a player won or if the cube is full.
+func (g *Game) Over() bool {
+	winner, _ := g.Winner()
+	return winner != rules.None || g.Full()
+}
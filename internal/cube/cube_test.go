@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package cube
+
+import (
+	"GoRythm/internal/rules"
+	"errors"
+	"testing"
+)
+
+// TestNew tests the New function.
+// Checks if only the sizes 3 and 4 are accepted.
+func TestNew(t *testing.T) {
+	for size := 2; size <= 5; size++ {
+		_, err := New(size, rules.X)
+		if valid := size == 3 || size == 4; valid != (err == nil) {
+			t.Errorf("Expected valid %v for size %d, got %v", valid, size, err)
+		}
+	}
+}
+
+// TestGame_Winner tests the Winner function.
+// Checks if a diagonal through the layers of the cube wins the game.
+func TestGame_Winner(t *testing.T) {
+	g, _ := New(4, rules.X)
+	for i := 0; i < 4; i++ {
+		if err := g.Play(Move{i, 3 - i, i}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if i < 3 {
+			g.Play(Move{0, 0, i + 1})
+		}
+	}
+	winner, line := g.Winner()
+	if winner != rules.X || len(line) != 4 {
+		t.Fatalf("Expected X to win with 4 cells, got %q %v", winner, line)
+	}
+	if err := g.Play(Move{1, 1, 1}); !errors.Is(err, ErrGameOver) {
+		t.Errorf("Expected %v, got %v", ErrGameOver, err)
+	}
+}
+
+// TestGame_Play tests the Play function.
+// Checks if an occupied cell or a cell out of the cube is refused.
+func TestGame_Play(t *testing.T) {
+	g, _ := New(3, rules.O)
+	g.Play(Move{1, 1, 1})
+	if err := g.Play(Move{1, 1, 1}); !errors.Is(err, ErrIllegalMove) {
+		t.Errorf("Expected %v, got %v", ErrIllegalMove, err)
+	}
+	if err := g.Play(Move{0, 0, 3}); !errors.Is(err, ErrIllegalMove) {
+		t.Errorf("Expected %v, got %v", ErrIllegalMove, err)
+	}
+	if g.Turn != rules.X || len(g.Moves()) != 26 {
+		t.Errorf("Expected X to play one of 26 moves, got %s with %d moves", g.Turn, len(g.Moves()))
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"GoRythm/internal/log"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	AnnounceInterval = time.Second // The interval between two announcements
+)
+
+// An Announcer struct announces a game periodically until it is closed.
+type Announcer struct {
+	conn     *net.UDPConn
+	describe func() Announcement // Returns the current description of the game
+	interval time.Duration
+	done     chan struct{}
+	once     sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewAnnouncer creates a new announcer sending to the given UDP address, a multicast group
+// or a unicast address. The game is described by calling describe before each announcement.
+func NewAnnouncer(target string, describe func() Announcement) (*Announcer, error) {
+	addr, err := net.ResolveUDPAddr("udp", target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", target, err)
+	}
+	conn, err := net.DialUDP("udp", nil, addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to announce on %s: %w", target, err)
+	}
+	return &Announcer{
+		conn:     conn,
+		describe: describe,
+		interval: AnnounceInterval,
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// Start starts announcing the game in the background.
+func (a *Announcer) Start() {
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		for {
+			if err := a.Announce(); err != nil {
+				log.LogMessage(log.DEBUG, "failed to announce the game: "+err.Error())
+			}
+			select {
+			case <-a.done:
+				return
+			case <-time.After(a.interval):
+			}
+		}
+	}()
+}
+
+// Announce sends the current description of the game once.
+func (a *Announcer) Announce() error {
+	data, err := a.describe().encode()
+	if err != nil {
+		return err
+	}
+	_, err = a.conn.Write(data)
+	return err
+}
+
+// Close stops the announcements.
+func (a *Announcer) Close() error {
+	a.once.Do(func() { close(a.done) })
+	a.wg.Wait()
+	return a.conn.Close()
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"GoRythm/internal/log"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	GameTimeout = 3 * AnnounceInterval // The time after which a silent host is removed
+)
+
+// A Game struct contains an announced game and the address to join it.
+type Game struct {
+	Announcement
+	Addr     string    // The TCP address of the game server
+	LastSeen time.Time // The last time the game was announced
+}
+
+// A Browser struct listens to the announcements and keeps the list of the announced games.
+type Browser struct {
+	conn    *net.UDPConn
+	timeout time.Duration
+
+	mu    sync.Mutex
+	games map[string]Game // The games by address
+	wg    sync.WaitGroup
+}
+
+// NewBrowser creates a new browser listening on the given UDP address. A multicast group
+// is joined on all the interfaces, and can be listened by several processes at once.
+func NewBrowser(listen string) (*Browser, error) {
+	addr, err := net.ResolveUDPAddr("udp", listen)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", listen, err)
+	}
+	var conn *net.UDPConn
+	if addr.IP != nil && addr.IP.IsMulticast() {
+		conn, err = net.ListenMulticastUDP("udp", nil, addr)
+	} else {
+		conn, err = net.ListenUDP("udp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", listen, err)
+	}
+
+	b := &Browser{
+		conn:    conn,
+		timeout: GameTimeout,
+		games:   map[string]Game{},
+	}
+	b.wg.Add(1)
+	go b.listen()
+	return b, nil
+}
+
+// Addr returns the UDP address the browser listens on.
+func (b *Browser) Addr() string {
+	return b.conn.LocalAddr().String()
+}
+
+// Games returns the games announced recently, sorted by name and address.
+func (b *Browser) Games() []Game {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var games []Game
+	for addr, game := range b.games {
+		if time.Since(game.LastSeen) > b.timeout {
+			delete(b.games, addr)
+			continue
+		}
+		games = append(games, game)
+	}
+	sort.Slice(games, func(i, j int) bool {
+		if games[i].Name != games[j].Name {
+			return games[i].Name < games[j].Name
+		}
+		return games[i].Addr < games[j].Addr
+	})
+	return games
+}
+
+// Close stops listening to the announcements.
+func (b *Browser) Close() error {
+	err := b.conn.Close()
+	b.wg.Wait()
+	return err
+}
+
+// listen receives the announcements until the browser is closed.
+func (b *Browser) listen() {
+	defer b.wg.Done()
+	buffer := make([]byte, maxDatagram)
+	for {
+		n, from, err := b.conn.ReadFromUDP(buffer)
+		if err != nil {
+			return
+		}
+		announcement, err := decodeAnnouncement(buffer[:n])
+		if err != nil {
+			log.LogMessage(log.DEBUG, fmt.Sprintf("invalid announcement from %s: %v", from, err))
+			continue
+		}
+		addr := hostAddress(from, announcement.Port)
+		b.mu.Lock()
+		b.games[addr] = Game{Announcement: announcement, Addr: addr, LastSeen: time.Now()}
+		b.mu.Unlock()
+	}
+}
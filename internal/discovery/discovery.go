@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package discovery provides the discovery of the GoRythm hosts on the local network.
+// The hosts announce their games periodically with UDP datagrams sent to a multicast group,
+// and the lobby of the game listens to them to list the open games.
+//
+// A unicast address can be used instead of the multicast group, for example on the
+// loopback interface.
+package discovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+)
+
+const (
+	DefaultGroup = "239.255.42.42:4243" // The default multicast group of the announcements
+	maxDatagram  = 1024                 // The maximum size of an announcement
+)
+
+// An Announcement struct contains the description of a game announced by a host.
+type Announcement struct {
+	Name    string `json:"name"`    // The name of the host
+	Port    int    `json:"port"`    // The TCP port of the game server
+	Mode    string `json:"mode"`    // The rules of the game
+	Song    string `json:"song"`    // The music of the game
+	Players int    `json:"players"` // The number of connected players
+	Open    bool   `json:"open"`    // Whether a player can join
+}
+
+// encode returns the datagram of an announcement.
+func (a Announcement) encode() ([]byte, error) {
+	return json.Marshal(a)
+}
+
+// decodeAnnouncement parses the datagram of an announcement.
+func decodeAnnouncement(data []byte) (Announcement, error) {
+	var a Announcement
+	if err := json.Unmarshal(data, &a); err != nil {
+		return a, err
+	}
+	if a.Port <= 0 || a.Port > 65535 {
+		return a, fmt.Errorf("invalid port %d", a.Port)
+	}
+	return a, nil
+}
+
+// hostAddress returns the TCP address of the game from the address that sent the announcement.
+func hostAddress(from *net.UDPAddr, port int) string {
+	return net.JoinHostPort(from.IP.String(), strconv.Itoa(port))
+}
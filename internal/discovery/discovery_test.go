@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"GoRythm/internal/network"
+	"testing"
+	"time"
+)
+
+const waitTimeout = 2 * time.Second
+
+// newLoopbackBrowser creates a browser on the loopback interface and closes it at the end of the test.
+func newLoopbackBrowser(t *testing.T) *Browser {
+	t.Helper()
+	b, err := NewBrowser("127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	t.Cleanup(func() { b.Close() })
+	return b
+}
+
+// announce announces a game once to the browser.
+func announce(t *testing.T, b *Browser, a Announcement) {
+	t.Helper()
+	announcer, err := NewAnnouncer(b.Addr(), func() Announcement { return a })
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer announcer.Close()
+	if err := announcer.Announce(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+// waitGames waits until the browser lists the given number of games.
+func waitGames(t *testing.T, b *Browser, count int) []Game {
+	t.Helper()
+	deadline := time.Now().Add(waitTimeout)
+	for {
+		games := b.Games()
+		if len(games) == count {
+			return games
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected %d games, got %d", count, len(games))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// TestBrowser_Games tests the discovery of two hosts on the loopback interface.
+// Checks if both games are listed, sorted by name, with their address.
+func TestBrowser_Games(t *testing.T) {
+	b := newLoopbackBrowser(t)
+	announce(t, b, Announcement{Name: "bob", Port: 4300, Mode: "classic", Song: "Track 1", Open: true})
+	announce(t, b, Announcement{Name: "alice", Port: 4301, Mode: "gorythm", Song: "Track 1", Players: 1, Open: true})
+
+	games := waitGames(t, b, 2)
+	if games[0].Name != "alice" || games[1].Name != "bob" {
+		t.Fatalf("Expected alice then bob, got %s then %s", games[0].Name, games[1].Name)
+	}
+	if games[0].Addr != "127.0.0.1:4301" {
+		t.Errorf("Expected address 127.0.0.1:4301, got %s", games[0].Addr)
+	}
+	if games[0].Mode != "gorythm" || games[0].Players != 1 {
+		t.Errorf("Expected the announced mode and players, got %s and %d", games[0].Mode, games[0].Players)
+	}
+
+	// A new announcement of the same host replaces the old one
+	announce(t, b, Announcement{Name: "bob", Port: 4300, Mode: "classic", Players: 2})
+	deadline := time.Now().Add(waitTimeout)
+	for b.Games()[1].Players != 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected the announcement of bob to be updated")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// TestBrowser_Timeout tests the removal of the silent hosts.
+// Checks if a game is removed when it is not announced anymore.
+func TestBrowser_Timeout(t *testing.T) {
+	b := newLoopbackBrowser(t)
+	b.timeout = 50 * time.Millisecond
+	announce(t, b, Announcement{Name: "alice", Port: 4300})
+	waitGames(t, b, 1)
+	time.Sleep(2 * b.timeout)
+	if games := b.Games(); len(games) != 0 {
+		t.Errorf("Expected the game to be removed, got %d games", len(games))
+	}
+}
+
+// TestAnnouncer_Start tests the periodic announcements.
+// Checks if a started announcer is discovered.
+func TestAnnouncer_Start(t *testing.T) {
+	b := newLoopbackBrowser(t)
+	announcer, err := NewAnnouncer(b.Addr(), func() Announcement {
+		return Announcement{Name: "alice", Port: 4300}
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	announcer.Start()
+	defer announcer.Close()
+	waitGames(t, b, 1)
+}
+
+// TestDecodeAnnouncement tests the decodeAnnouncement function.
+// Checks if invalid datagrams are refused.
+func TestDecodeAnnouncement(t *testing.T) {
+	if _, err := decodeAnnouncement([]byte("not json")); err == nil {
+		t.Error("Expected an error for an invalid datagram")
+	}
+	if _, err := decodeAnnouncement([]byte(`{"name":"alice","port":0}`)); err == nil {
+		t.Error("Expected an error for an invalid port")
+	}
+	if a, err := decodeAnnouncement([]byte(`{"name":"alice","port":4242}`)); err != nil || a.Name != "alice" {
+		t.Errorf("Expected the announcement of alice, got %v and %v", a, err)
+	}
+}
+
+// TestServerAnnouncement tests the ServerAnnouncement function with a loopback server.
+// Checks if the port, mode and players of the server are announced.
+func TestServerAnnouncement(t *testing.T) {
+	server := network.NewServer(network.ClassicMode, nil)
+	if err := server.Listen("127.0.0.1:0"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	go server.Serve()
+	defer server.Close()
+
+	a := ServerAnnouncement("alice", server)
+	if a.Name != "alice" || a.Port != server.Port() || a.Mode != "classic" {
+		t.Errorf("Expected the name, port and mode of the server, got %v", a)
+	}
+	if a.Players != 0 || !a.Open {
+		t.Errorf("Expected an open server without players, got %v", a)
+	}
+}
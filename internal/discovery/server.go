@@ -0,0 +1,22 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"GoRythm/internal/network"
+	"GoRythm/internal/rhythm"
+)
+
+// ServerAnnouncement returns the current description of the games of a server
+// hosted under the given name.
+func ServerAnnouncement(name string, server *network.Server) Announcement {
+	return Announcement{
+		Name:    name,
+		Port:    server.Port(),
+		Mode:    string(server.Mode()),
+		Song:    rhythm.SongName,
+		Players: server.Players(),
+		Open:    server.Open(),
+	}
+}
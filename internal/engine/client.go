@@ -0,0 +1,245 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"GoRythm/internal/ai"
+	"GoRythm/internal/log"
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	handshakeTimeout = 5 * time.Second       // The time the engine has to answer the protocol command
+	quitTimeout      = 2 * time.Second       // The time the engine has to exit after the quit command
+	answerMargin     = 50 * time.Millisecond // The time kept before the deadline for the answer to reach the game
+)
+
+var (
+	ErrUnsupportedPosition = errors.New("the engine only plays the 3x3 games")
+	ErrEngineExited        = errors.New("the engine exited")
+	ErrEngine              = errors.New("engine error")
+	ErrHandshake           = errors.New("the engine did not answer the protocol command")
+)
+
+// An Engine struct is an external engine playing the 3x3 games. It is a ContextStrategy, the
+// engine is asked for its move and must answer before the deadline of the context.
+type Engine struct {
+	name  string
+	w     io.WriteCloser
+	lines <-chan string // The lines written by the engine, closed when it exits
+	cmd   *exec.Cmd     // The process of the engine, nil if it is not run by the game
+	stale int           // The number of moves still to be answered for searches stopped at their deadline
+
+	mu sync.Mutex // Allows one command at a time
+}
+
+// Start runs the engine of the command line, as "./bot --level 3", and waits for it to be ready.
+// The errors of the engine are written to the standard error of the game.
+func Start(command string) (*Engine, error) {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return nil, errors.New("no engine command")
+	}
+	cmd := exec.Command(fields[0], fields[1:]...)
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start the engine: %w", err)
+	}
+	e, err := New(stdout, stdin)
+	if err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+		return nil, err
+	}
+	e.cmd = cmd
+	return e, nil
+}
+
+// New talks to an engine reading its lines from r and writing the commands to w, and waits for
+// it to be ready.
+func New(r io.Reader, w io.WriteCloser) (*Engine, error) {
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+	e := &Engine{w: w, lines: lines}
+	if err := e.handshake(); err != nil {
+		e.drain()
+		return nil, err
+	}
+	return e, nil
+}
+
+// handshake sends the protocol command and waits for the engine to be ready.
+func (e *Engine) handshake() error {
+	if err := e.send(fmt.Sprintf("protocol %d", ProtocolVersion)); err != nil {
+		return err
+	}
+	timeout := time.After(handshakeTimeout)
+	for {
+		select {
+		case line, ok := <-e.lines:
+			if !ok {
+				return ErrEngineExited
+			}
+			command, argument, _ := strings.Cut(line, " ")
+			switch command {
+			case "engine":
+				e.name = argument
+			case "ready":
+				if e.name == "" {
+					e.name = "engine"
+				}
+				return nil
+			case "error":
+				return fmt.Errorf("%w: %s", ErrEngine, argument)
+			}
+		case <-timeout:
+			return ErrHandshake
+		}
+	}
+}
+
+// drain reads the lines of the engine nobody waits for anymore until it exits, so that the
+// goroutine reading them is never blocked.
+func (e *Engine) drain() {
+	go func() {
+		for range e.lines {
+		}
+	}()
+}
+
+// Name returns the name of the engine.
+func (e *Engine) Name() string {
+	return e.name
+}
+
+// Register registers the engine as the strategy "engine:<name>" and returns its name.
+func (e *Engine) Register() string {
+	name := "engine:" + e.name
+	ai.Register(name, func() ai.Strategy { return e })
+	return name
+}
+
+// send writes a command to the engine.
+func (e *Engine) send(command string) error {
+	_, err := io.WriteString(e.w, command+"\n")
+	return err
+}
+
+// Move returns the move of the engine, without time limit.
+func (e *Engine) Move(p ai.Position, r *rand.Rand) (int, error) {
+	return e.MoveContext(context.Background(), p, r)
+}
+
+// MoveContext sends the position to the engine and returns its move, checked to be legal. The
+// engine is given the time until shortly before the deadline of the context, and is stopped if
+// it does not answer in time. The random number generator is not used, the engine has its own.
+func (e *Engine) MoveContext(ctx context.Context, p ai.Position, r *rand.Rand) (int, error) {
+	classic, ok := p.(*ai.Classic)
+	if !ok {
+		return 0, fmt.Errorf("%w: %T", ErrUnsupportedPosition, p)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	limit := int64(0)
+	if deadline, ok := ctx.Deadline(); ok {
+		limit = max((time.Until(deadline) - answerMargin).Milliseconds(), 1)
+	}
+	if err := e.send("position " + FormatPosition(classic.Game)); err != nil {
+		return 0, err
+	}
+	if err := e.send(fmt.Sprintf("go %d", limit)); err != nil {
+		return 0, err
+	}
+	for {
+		select {
+		case line, ok := <-e.lines:
+			if !ok {
+				return 0, ErrEngineExited
+			}
+			command, argument, _ := strings.Cut(line, " ")
+			switch command {
+			case "info":
+				log.LogMessage(log.DEBUG, e.name+": "+argument)
+			case "move", "error":
+				// The answer of a search stopped before is dropped
+				if e.stale > 0 {
+					e.stale--
+					continue
+				}
+				if command == "error" {
+					return 0, fmt.Errorf("%w: %s", ErrEngine, argument)
+				}
+				return e.checkMove(classic, argument)
+			}
+		case <-ctx.Done():
+			e.stale++
+			e.send("stop")
+			return 0, ctx.Err()
+		}
+	}
+}
+
+// checkMove returns the number of the move answered by the engine, or an error if it is not a
+// legal move of the position.
+func (e *Engine) checkMove(p *ai.Classic, answer string) (int, error) {
+	if answer == "none" {
+		return 0, ai.ErrNoMove
+	}
+	m, err := ParseMove(answer)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", e.name, err)
+	}
+	if err := p.Game.Legal(m); err != nil {
+		return 0, fmt.Errorf("%s played %s: %w", e.name, answer, err)
+	}
+	return ai.ClassicMove(m), nil
+}
+
+// Close asks the engine to quit and waits for its process to exit, which is killed if it is too slow.
+func (e *Engine) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.send("quit")
+	err := e.w.Close()
+	e.drain()
+	if e.cmd == nil {
+		return err
+	}
+	exited := make(chan error, 1)
+	go func() {
+		exited <- e.cmd.Wait()
+	}()
+	select {
+	case err = <-exited:
+	case <-time.After(quitTimeout):
+		e.cmd.Process.Kill()
+		err = <-exited
+	}
+	return err
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"GoRythm/internal/ai"
+	"GoRythm/internal/rules"
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+// serve returns an engine talking to Serve playing the strategy.
+func serve(t *testing.T, name string, s ai.Strategy) *Engine {
+	commands, commandsW := io.Pipe()
+	answers, answersW := io.Pipe()
+	go func() {
+		Serve(name, s, commands, answersW, rand.New(rand.NewSource(1)))
+		answersW.Close()
+	}()
+	e, err := New(answers, commandsW)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	t.Cleanup(func() { e.Close() })
+	return e
+}
+
+// fake returns an engine talking to the handler, called with each command after the handshake
+// and the function writing an answer.
+func fake(t *testing.T, handler func(command string, answer func(line string))) *Engine {
+	commands, commandsW := io.Pipe()
+	answers, answersW := io.Pipe()
+	go func() {
+		defer answersW.Close()
+		answer := func(line string) { fmt.Fprintln(answersW, line) }
+		scanner := bufio.NewScanner(commands)
+		for scanner.Scan() {
+			if scanner.Text() == "protocol 1" {
+				answer("engine fake")
+				answer("ready")
+				continue
+			}
+			handler(scanner.Text(), answer)
+		}
+	}()
+	e, err := New(answers, commandsW)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	t.Cleanup(func() { e.Close() })
+	return e
+}
+
+// slow is a strategy playing its first legal move after a delay.
+type slow time.Duration
+
+// Move returns the first legal move after the delay.
+func (s slow) Move(p ai.Position, r *rand.Rand) (int, error) {
+	time.Sleep(time.Duration(s))
+	return p.Moves()[0], nil
+}
+
+// TestEngine_Move tests the Move function.
+// Checks if two reference engines play a draw and the engine is registered under its name.
+func TestEngine_Move(t *testing.T) {
+	e := serve(t, "minimax", ai.Minimax{})
+	if e.Name() != "minimax" {
+		t.Errorf("Expected the name minimax, got %s", e.Name())
+	}
+	p := ai.NewClassic(rules.X, rules.Variant{})
+	r := rand.New(rand.NewSource(1))
+	for !p.Over() {
+		move, err := e.Move(p, r)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if err := p.Play(move); err != nil {
+			t.Fatalf("Expected a legal move, got %v", err)
+		}
+	}
+	if winner := p.Winner(); winner != rules.None {
+		t.Errorf("Expected a draw, got a win of %s", winner)
+	}
+	if _, err := e.Move(p, r); !errors.Is(err, ai.ErrNoMove) {
+		t.Errorf("Expected %v on a finished game, got %v", ai.ErrNoMove, err)
+	}
+
+	name := e.Register()
+	if s, err := ai.New(name); err != nil || s != ai.Strategy(e) {
+		t.Errorf("Expected the engine registered as %s, got %v (%v)", name, s, err)
+	}
+}
+
+// TestEngine_MoveContext tests the MoveContext function.
+// Checks if a slow strategy is given the time limit and answers with a random legal move.
+func TestEngine_MoveContext(t *testing.T) {
+	e := serve(t, "slow", slow(time.Second))
+	p := ai.NewClassic(rules.X, rules.Variant{})
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	move, err := e.MoveContext(ctx, p, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := p.Play(move); err != nil {
+		t.Errorf("Expected a legal move, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 800*time.Millisecond {
+		t.Errorf("Expected the move before the deadline, got it after %v", elapsed)
+	}
+}
+
+// TestEngine_MoveContext_stale tests the MoveContext function.
+// Checks if the late answer of a stopped search is not taken as the answer of the next one.
+func TestEngine_MoveContext_stale(t *testing.T) {
+	late, answered := make(chan struct{}), make(chan struct{})
+	searches := 0
+	e := fake(t, func(command string, answer func(string)) {
+		if !strings.HasPrefix(command, "go") {
+			return
+		}
+		searches++
+		if searches == 1 {
+			go func() {
+				<-late
+				answer("move Xa1")
+				close(answered)
+			}()
+			return
+		}
+		<-answered
+		answer("info searching")
+		answer("move Xb2")
+	})
+	p := ai.NewClassic(rules.X, rules.Variant{})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := e.MoveContext(ctx, p, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	close(late)
+	move, err := e.Move(p, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if m := ai.ClassicCell(move); m != (rules.Move{X: 1, Y: 1, Symbol: rules.X}) {
+		t.Errorf("Expected the center, got %v", m)
+	}
+}
+
+// TestEngine_Move_invalid tests the Move function.
+// Checks if the illegal moves, the errors of the engine and the other games are refused.
+func TestEngine_Move_invalid(t *testing.T) {
+	answer := "move Ob2"
+	e := fake(t, func(command string, write func(string)) {
+		if strings.HasPrefix(command, "go") {
+			write(answer)
+		}
+	})
+	p := ai.NewClassic(rules.X, rules.Variant{})
+	if _, err := e.Move(p, nil); !errors.Is(err, rules.ErrInvalidSymbol) {
+		t.Errorf("Expected %v for a move of O, got %v", rules.ErrInvalidSymbol, err)
+	}
+	answer = "move Xz9"
+	if _, err := e.Move(p, nil); !errors.Is(err, ErrInvalidMove) {
+		t.Errorf("Expected %v, got %v", ErrInvalidMove, err)
+	}
+	answer = "error lost"
+	if _, err := e.Move(p, nil); !errors.Is(err, ErrEngine) {
+		t.Errorf("Expected %v, got %v", ErrEngine, err)
+	}
+	if _, err := e.Move(ai.NewUltimate(rules.X), nil); !errors.Is(err, ErrUnsupportedPosition) {
+		t.Errorf("Expected %v, got %v", ErrUnsupportedPosition, err)
+	}
+}
+
+// TestNew_error tests the New function with an engine refusing the protocol.
+// Checks if the error is returned and the lines written after it are still read.
+func TestNew_error(t *testing.T) {
+	commands, commandsW := io.Pipe()
+	answers, answersW := io.Pipe()
+	go io.Copy(io.Discard, commands)
+	written := make(chan error, 1)
+	go func() {
+		defer answersW.Close()
+		// Writes to a pipe block until their lines are read
+		for _, line := range []string{"error unsupported protocol", "engine late", "ready", "bye"} {
+			if _, err := fmt.Fprintln(answersW, line); err != nil {
+				written <- err
+				return
+			}
+		}
+		written <- nil
+	}()
+	if _, err := New(answers, commandsW); !errors.Is(err, ErrEngine) {
+		t.Fatalf("Expected %v, got %v", ErrEngine, err)
+	}
+	select {
+	case err := <-written:
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("Expected the lines after the error to be read")
+	}
+}
+
+// TestServe tests the Serve function.
+// Checks if the unknown commands and the search without position are answered by errors.
+func TestServe(t *testing.T) {
+	var out strings.Builder
+	in := strings.NewReader("protocol 2\nprotocol 1\nhello\ngo 10\nposition classic X Xb2 Xa1\nquit\ngo 10\n")
+	if err := Serve("test", ai.Random{}, in, &out, rand.New(rand.NewSource(1))); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	want := []string{"error unsupported", "engine test", "ready", "error unknown", "error no position", "error move 2"}
+	if len(lines) != len(want) {
+		t.Fatalf("Expected %d lines, got %q", len(want), lines)
+	}
+	for i, line := range lines {
+		if !strings.HasPrefix(line, want[i]) {
+			t.Errorf("Expected line %d to start with %q, got %q", i+1, want[i], line)
+		}
+	}
+}
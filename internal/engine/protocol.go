@@ -0,0 +1,144 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package engine lets external programs play the 3x3 games as AI strategies. The game runs the
+// engine as a process and talks to it with a line-based protocol over its standard input and
+// output, so that engines can be written in any language:
+//
+//	game → engine                        engine → game
+//	protocol 1                           engine <name>
+//	                                     ready
+//	position <variant> <first> [moves…]
+//	go <milliseconds>                    info <text>  (any number, logged)
+//	                                     move <move>  (or "move none")
+//	stop
+//	quit
+//
+// The variant is "classic" or the enabled variants separated by commas, as "misere,wild" or
+// "notakto,max3". The first player is X or O, followed by the moves played since the empty
+// board. A move is the symbol placed and its cell, the column a to c from the left and the row 1
+// to 3 from the top: "Xb2" places X in the center. "go" asks for the move of the player to move
+// within the given time, 0 for no limit, and is answered by exactly one "move" line. "stop" asks
+// for the move at once. A command the engine cannot handle is answered by "error <text>".
+package engine
+
+import (
+	"GoRythm/internal/rules"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	ProtocolVersion = 1 // The version of the protocol sent by the game
+)
+
+var (
+	ErrInvalidMove    = errors.New("invalid move")
+	ErrInvalidVariant = errors.New("invalid variant")
+)
+
+// FormatMove returns the move as written in the protocol, as "Xb2".
+func FormatMove(m rules.Move) string {
+	return fmt.Sprintf("%s%c%d", m.Symbol, 'a'+m.X, m.Y+1)
+}
+
+// ParseMove parses a move written as in the protocol.
+func ParseMove(s string) (rules.Move, error) {
+	if len(s) != 3 {
+		return rules.Move{}, fmt.Errorf("%w: %q", ErrInvalidMove, s)
+	}
+	m := rules.Move{Symbol: rules.Symbol(strings.ToUpper(s[:1])), X: int(s[1] - 'a'), Y: int(s[2] - '1')}
+	if !m.Symbol.Valid() || !rules.InBounds(m.X, m.Y) {
+		return rules.Move{}, fmt.Errorf("%w: %q", ErrInvalidMove, s)
+	}
+	return m, nil
+}
+
+// FormatVariant returns the variant as written in the protocol, as "misere,max3".
+func FormatVariant(v rules.Variant) string {
+	var names []string
+	if v.Misere {
+		names = append(names, "misere")
+	}
+	if v.Wild {
+		names = append(names, "wild")
+	}
+	if v.Notakto {
+		names = append(names, "notakto")
+	}
+	if v.MaxSymbols > 0 {
+		names = append(names, fmt.Sprintf("max%d", v.MaxSymbols))
+	}
+	if len(names) == 0 {
+		return "classic"
+	}
+	return strings.Join(names, ",")
+}
+
+// ParseVariant parses a variant written as in the protocol.
+func ParseVariant(s string) (rules.Variant, error) {
+	var v rules.Variant
+	for _, name := range strings.Split(s, ",") {
+		switch {
+		case name == "classic":
+		case name == "misere":
+			v.Misere = true
+		case name == "wild":
+			v.Wild = true
+		case name == "notakto":
+			v.Notakto = true
+		case strings.HasPrefix(name, "max"):
+			limit, err := strconv.Atoi(strings.TrimPrefix(name, "max"))
+			if err != nil || limit < rules.MinSymbolLimit || limit > rules.MaxSymbolLimit {
+				return v, fmt.Errorf("%w: %q", ErrInvalidVariant, name)
+			}
+			v.MaxSymbols = limit
+		default:
+			return v, fmt.Errorf("%w: %q", ErrInvalidVariant, name)
+		}
+	}
+	return v.Normalize(), nil
+}
+
+// FormatPosition returns the arguments of the position command of the game: its variant, its
+// first player and its moves.
+func FormatPosition(g *rules.Game) string {
+	first := g.Turn
+	// The turn switches after each move
+	if len(g.History)%2 == 1 {
+		first = first.Opponent()
+	}
+	fields := []string{FormatVariant(g.Variant), string(first)}
+	for _, m := range g.History {
+		fields = append(fields, FormatMove(m))
+	}
+	return strings.Join(fields, " ")
+}
+
+// ParsePosition returns the game of the arguments of a position command, with its moves played.
+func ParsePosition(fields []string) (*rules.Game, error) {
+	if len(fields) < 2 {
+		return nil, errors.New("the position needs a variant and a first player")
+	}
+	variant, err := ParseVariant(fields[0])
+	if err != nil {
+		return nil, err
+	}
+	first := rules.Symbol(strings.ToUpper(fields[1]))
+	if !first.Valid() {
+		return nil, fmt.Errorf("%w: %q", rules.ErrInvalidPlayer, fields[1])
+	}
+	g := rules.NewGame(first, variant)
+	for i, field := range fields[2:] {
+		m, err := ParseMove(field)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := g.Play(m); err != nil {
+			return nil, fmt.Errorf("move %d %s: %w", i+1, field, err)
+		}
+	}
+	return g, nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"GoRythm/internal/rules"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestParseMove tests the ParseMove function.
+// Checks if the formatted moves are parsed back and the invalid moves refused.
+func TestParseMove(t *testing.T) {
+	for x := 0; x < 3; x++ {
+		for y := 0; y < 3; y++ {
+			for _, symbol := range []rules.Symbol{rules.X, rules.O} {
+				m := rules.Move{X: x, Y: y, Symbol: symbol}
+				got, err := ParseMove(FormatMove(m))
+				if err != nil || got != m {
+					t.Errorf("Expected %v from %q, got %v (%v)", m, FormatMove(m), got, err)
+				}
+			}
+		}
+	}
+	if s := FormatMove(rules.Move{X: 1, Y: 1, Symbol: rules.X}); s != "Xb2" {
+		t.Errorf("Expected Xb2 for the center, got %s", s)
+	}
+	for _, s := range []string{"", "Xd1", "Xa4", "Za1", "Xb22"} {
+		if _, err := ParseMove(s); !errors.Is(err, ErrInvalidMove) {
+			t.Errorf("Expected %v for %q, got %v", ErrInvalidMove, s, err)
+		}
+	}
+}
+
+// TestParseVariant tests the ParseVariant function.
+// Checks if the formatted variants are parsed back and the unknown ones refused.
+func TestParseVariant(t *testing.T) {
+	variants := []rules.Variant{{}, {Misere: true}, {Wild: true, MaxSymbols: 3}, {Notakto: true}}
+	for _, v := range variants {
+		v = v.Normalize()
+		got, err := ParseVariant(FormatVariant(v))
+		if err != nil || got != v {
+			t.Errorf("Expected %v from %q, got %v (%v)", v, FormatVariant(v), got, err)
+		}
+	}
+	for _, s := range []string{"", "chess", "max", "max99"} {
+		if _, err := ParseVariant(s); !errors.Is(err, ErrInvalidVariant) {
+			t.Errorf("Expected %v for %q, got %v", ErrInvalidVariant, s, err)
+		}
+	}
+}
+
+// TestParsePosition tests the ParsePosition function.
+// Checks if a formatted game is parsed back with its moves and an illegal move refused.
+func TestParsePosition(t *testing.T) {
+	g := rules.NewGame(rules.O, rules.Variant{Misere: true})
+	g.Play(rules.Move{X: 1, Y: 1, Symbol: rules.O})
+	g.Play(rules.Move{X: 0, Y: 2, Symbol: rules.X})
+	g.Play(rules.Move{X: 2, Y: 0, Symbol: rules.O})
+
+	s := FormatPosition(g)
+	if s != "misere O Ob2 Xa3 Oc1" {
+		t.Errorf("Expected misere O Ob2 Xa3 Oc1, got %s", s)
+	}
+	got, err := ParsePosition(strings.Fields(s))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got.Board != g.Board || got.Turn != g.Turn || got.Variant != g.Variant || len(got.History) != 3 {
+		t.Errorf("Expected the game %v, got %v", g, got)
+	}
+
+	if _, err := ParsePosition([]string{"classic", "X", "Xb2", "Ob2"}); !errors.Is(err, rules.ErrCellTaken) {
+		t.Errorf("Expected %v, got %v", rules.ErrCellTaken, err)
+	}
+	if _, err := ParsePosition([]string{"classic", "Z"}); !errors.Is(err, rules.ErrInvalidPlayer) {
+		t.Errorf("Expected %v, got %v", rules.ErrInvalidPlayer, err)
+	}
+}
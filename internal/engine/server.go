@@ -0,0 +1,151 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"GoRythm/internal/ai"
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A server struct answers the commands of the game for an engine playing a strategy.
+type server struct {
+	name     string
+	strategy ai.Strategy
+	random   *rand.Rand
+	position *ai.Classic // The position of the last position command, nil before it
+
+	mu sync.Mutex // Protects the writer, shared with the search
+	w  io.Writer
+
+	cancel context.CancelFunc // Stops the running search, nil when none is running
+	done   chan struct{}      // Closed when the running search has answered
+}
+
+// Serve runs an engine playing the strategy, reading the commands of the game from r and writing
+// the answers to w, until the quit command or the end of r. The random numbers of the strategy
+// are drawn from the generator.
+func Serve(name string, s ai.Strategy, r io.Reader, w io.Writer, rnd *rand.Rand) error {
+	srv := &server{name: name, strategy: s, random: rnd, w: w}
+	defer srv.stop()
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "quit" {
+			return nil
+		}
+		if err := srv.handle(fields[0], fields[1:]); err != nil {
+			if err := srv.send("error " + err.Error()); err != nil {
+				return err
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// handle runs a command of the game, an error is returned to the game.
+func (srv *server) handle(command string, arguments []string) error {
+	switch command {
+	case "protocol":
+		if len(arguments) != 1 || arguments[0] != strconv.Itoa(ProtocolVersion) {
+			return fmt.Errorf("unsupported protocol %s, version %d expected", strings.Join(arguments, " "), ProtocolVersion)
+		}
+		if err := srv.send("engine " + srv.name); err != nil {
+			return err
+		}
+		return srv.send("ready")
+	case "position":
+		g, err := ParsePosition(arguments)
+		if err != nil {
+			// The next search must not play the previous position
+			srv.position = nil
+			return err
+		}
+		srv.position = &ai.Classic{Game: g}
+		return nil
+	case "go":
+		if len(arguments) != 1 {
+			return errors.New("go needs a time in milliseconds")
+		}
+		limit, err := strconv.Atoi(arguments[0])
+		if err != nil || limit < 0 {
+			return fmt.Errorf("invalid time %q", arguments[0])
+		}
+		return srv.start(time.Duration(limit) * time.Millisecond)
+	case "stop":
+		srv.stop()
+		return nil
+	default:
+		return fmt.Errorf("unknown command %q", command)
+	}
+}
+
+// send writes a line to the game.
+func (srv *server) send(line string) error {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	_, err := io.WriteString(srv.w, line+"\n")
+	return err
+}
+
+// start searches the move of the current position in a goroutine, which answers with the move
+// when it is found or when the time limit is reached. No limit is set for 0. A search still
+// running is stopped first and answers before.
+func (srv *server) start(limit time.Duration) error {
+	if srv.position == nil {
+		return errors.New("no position")
+	}
+	srv.stop()
+	ctx, cancel := context.WithCancel(context.Background())
+	if limit > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), limit)
+	}
+	position := srv.position.Clone().(*ai.Classic)
+	// The generator is not shared with the goroutine
+	r := rand.New(rand.NewSource(srv.random.Int63()))
+	done := make(chan struct{})
+	srv.cancel, srv.done = cancel, done
+	go func() {
+		defer close(done)
+		defer cancel()
+		srv.send("move " + srv.search(ctx, position, r))
+	}()
+	return nil
+}
+
+// search returns the move of the position as written in the answer. A random legal move is
+// played when the strategy fails or is stopped before finding one.
+func (srv *server) search(ctx context.Context, p *ai.Classic, r *rand.Rand) string {
+	moves := p.Moves()
+	if len(moves) == 0 {
+		return "none"
+	}
+	move, err := ai.PlayerOf(srv.strategy, r).Move(ctx, p, p.Turn())
+	if err != nil {
+		srv.send("info " + err.Error() + ", playing a random move")
+		move = moves[r.Intn(len(moves))]
+	}
+	return FormatMove(ai.ClassicCell(move))
+}
+
+// stop stops the running search, if any, and waits for its answer.
+func (srv *server) stop() {
+	if srv.cancel == nil {
+		return
+	}
+	srv.cancel()
+	<-srv.done
+	srv.cancel, srv.done = nil, nil
+}
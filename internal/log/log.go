@@ -2,11 +2,14 @@
 // SPDX-License-Identifier: Apache-2.0
 
 // Package log provides the utility fonction to log messages with different levels of severity.
-// The log level can be changed by modifying the CURRENT constant.
+// The log level is CURRENT by default and can be changed at runtime with SetLevel.
 package log
 
 import (
+	"fmt"
 	"log"
+	"strings"
+	"sync/atomic"
 )
 
 const (
@@ -18,9 +21,50 @@ const (
 	FATAL          // Fatal log level (exits the program without executing defer statements)
 )
 
+// The names of the log levels
+var levelNames = map[int]string{
+	DEBUG: "debug",
+	INFO:  "info",
+	WARN:  "warn",
+	ERROR: "error",
+	FATAL: "fatal",
+}
+
+// The log level used to filter the messages
+var level atomic.Int32
+
+func init() {
+	level.Store(CURRENT)
+}
+
+// SetLevel changes the log level, the messages below it are not logged anymore.
+func SetLevel(l int) {
+	level.Store(int32(l))
+}
+
+// Level returns the current log level.
+func Level() int {
+	return int(level.Load())
+}
+
+// LevelName returns the name of a log level ("debug", "info", "warn", "error" or "fatal").
+func LevelName(l int) string {
+	return levelNames[l]
+}
+
+// ParseLevel returns the log level with the given name.
+func ParseLevel(name string) (int, error) {
+	for l, n := range levelNames {
+		if n == strings.ToLower(name) {
+			return l, nil
+		}
+	}
+	return CURRENT, fmt.Errorf("unknown log level %q", name)
+}
+
 // Filters log messages based on the log level
 func LogMessage(level int, message string) {
-	if level >= CURRENT {
+	if level >= Level() {
 		switch level {
 		case DEBUG:
 			log.Printf("[DEBUG] %s", message)
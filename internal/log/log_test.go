@@ -0,0 +1,45 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"testing"
+)
+
+// TestSetLevel tests the SetLevel function.
+// Checks if the messages below the level are filtered.
+func TestSetLevel(t *testing.T) {
+	var buffer bytes.Buffer
+	log.SetOutput(&buffer)
+	defer log.SetOutput(os.Stderr)
+	defer SetLevel(CURRENT)
+
+	SetLevel(WARN)
+	LogMessage(INFO, "hidden")
+	if buffer.Len() != 0 {
+		t.Errorf("Expected no message below the level, got %q", buffer.String())
+	}
+	SetLevel(DEBUG)
+	LogMessage(DEBUG, "shown")
+	if !bytes.Contains(buffer.Bytes(), []byte("[DEBUG] shown")) {
+		t.Errorf("Expected the debug message, got %q", buffer.String())
+	}
+}
+
+// TestParseLevel tests the ParseLevel and LevelName functions.
+// Checks if every level is parsed back from its name and if unknown names are refused.
+func TestParseLevel(t *testing.T) {
+	for _, l := range []int{DEBUG, INFO, WARN, ERROR, FATAL} {
+		parsed, err := ParseLevel(LevelName(l))
+		if err != nil || parsed != l {
+			t.Errorf("Expected level %d, got %d and %v", l, parsed, err)
+		}
+	}
+	if _, err := ParseLevel("verbose"); err == nil {
+		t.Error("Expected an error for an unknown level")
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"GoRythm/internal/clocksync"
+	"GoRythm/internal/rules"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	dialTimeout   = 5 * time.Second       // The time allowed to connect to the server
+	eventsBuffer  = 64                    // The number of received messages buffered for the game loop
+	syncInterval  = time.Second           // The interval between two clock synchronisations
+	syncBurst     = 5                     // The number of synchronisations right after connecting
+	burstInterval = 50 * time.Millisecond // The interval between the first synchronisations
+)
+
+// A Client struct contains the connection of a player to a server.
+// The received messages are delivered on the Events channel to be polled by the game loop.
+// The clock of the client is kept synchronised with the server clock in the background.
+type Client struct {
+	conn   net.Conn
+	role   Role
+	symbol rules.Symbol
+	mode   Mode
+	events chan Message
+	clock  *clocksync.Estimator
+	done   chan struct{}
+	once   sync.Once
+
+	sendMu sync.Mutex
+	enc    *encoder
+}
+
+// Dial connects to the server at the given address with the player name and role, and
+// waits for the symbol assigned by the server. A spectator has no symbol.
+func Dial(addr, name string, role Role) (*Client, error) {
+	conn, err := net.DialTimeout("tcp", addr, dialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+	c := &Client{
+		conn:   conn,
+		events: make(chan Message, eventsBuffer),
+		clock:  clocksync.NewEstimator(clocksync.DefaultWindow),
+		done:   make(chan struct{}),
+		enc:    newEncoder(conn),
+	}
+	if err := c.send(Message{Type: MsgHello, Name: name, Role: role}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	dec := newDecoder(conn)
+	conn.SetReadDeadline(time.Now().Add(helloTimeout))
+	msg, err := dec.receive()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to join %s: %w", addr, err)
+	}
+	if msg.Type != MsgWelcome {
+		conn.Close()
+		return nil, fmt.Errorf("unexpected message %q from %s", msg.Type, addr)
+	}
+	conn.SetReadDeadline(time.Time{})
+	c.role = msg.Role
+	c.symbol = msg.Symbol
+	c.mode = msg.Mode
+
+	go c.readLoop(dec)
+	go c.syncLoop()
+	return c, nil
+}
+
+// Role returns the role of the client in the match.
+func (c *Client) Role() Role {
+	return c.role
+}
+
+// Symbol returns the symbol assigned to the player by the server.
+func (c *Client) Symbol() rules.Symbol {
+	return c.symbol
+}
+
+// Mode returns the mode of the match.
+func (c *Client) Mode() Mode {
+	return c.mode
+}
+
+// Events returns the channel of the received messages, closed when the connection is lost.
+func (c *Client) Events() <-chan Message {
+	return c.events
+}
+
+// SendMove sends a move with its music time (in seconds) to the server.
+func (c *Client) SendMove(x, y int, hitTime float64) error {
+	return c.send(Message{Type: MsgMove, X: x, Y: y, HitTime: hitTime})
+}
+
+// SendSongEnd tells the server the music ended.
+func (c *Client) SendSongEnd() error {
+	return c.send(Message{Type: MsgSongEnd})
+}
+
+// Synced returns true if the clock was synchronised with the server at least once.
+func (c *Client) Synced() bool {
+	return c.clock.Synced()
+}
+
+// ServerTime converts a local time to the server clock.
+func (c *Client) ServerTime(local time.Time) time.Time {
+	return c.clock.ServerTime(local)
+}
+
+// LocalTime converts a server time to the local clock.
+func (c *Client) LocalTime(server time.Time) time.Time {
+	return c.clock.LocalTime(server)
+}
+
+// Close closes the connection to the server.
+func (c *Client) Close() error {
+	c.once.Do(func() { close(c.done) })
+	return c.conn.Close()
+}
+
+// send writes a message to the server.
+func (c *Client) send(msg Message) error {
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+	return c.enc.send(msg)
+}
+
+// readLoop delivers the received messages until the connection is closed.
+// The clock synchronisation answers and the pings of the server are handled without being delivered.
+func (c *Client) readLoop(dec *decoder) {
+	defer close(c.events)
+	for {
+		msg, err := dec.receive()
+		if err != nil {
+			return
+		}
+		received := time.Now()
+		switch msg.Type {
+		case MsgPong:
+			c.clock.Add(clocksync.NewSample(time.Unix(0, msg.T0), time.Unix(0, msg.T1), time.Unix(0, msg.T2), received))
+		case MsgPing:
+			// The server measures the round-trip delay used to check the hit times
+			c.send(Message{Type: MsgPong, T0: msg.T0, T1: received.UnixNano(), T2: time.Now().UnixNano()})
+		default:
+			c.events <- msg
+		}
+	}
+}
+
+// syncLoop sends the clock synchronisation requests until the client is closed,
+// with a short burst right after connecting.
+func (c *Client) syncLoop() {
+	for i := 0; ; i++ {
+		interval := syncInterval
+		if i < syncBurst {
+			interval = burstInterval
+		}
+		msg := Message{Type: MsgPing, T0: time.Now().UnixNano()}
+		if err := c.send(msg); err != nil {
+			return
+		}
+		select {
+		case <-c.done:
+			return
+		case <-time.After(interval):
+		}
+	}
+}
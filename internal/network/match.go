@@ -0,0 +1,166 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"GoRythm/internal/rhythm"
+	"GoRythm/internal/rules"
+	"errors"
+)
+
+const (
+	maxSymbols  = 3 // The maximum number of symbols per player in GoRythm mode
+	scorePerWin = 1 // The score per win in Classic mode
+)
+
+var (
+	ErrMatchOver   = errors.New("the match is over")
+	ErrNotYourTurn = errors.New("it is not your turn")
+	ErrOutOfBoard  = errors.New("the position is out of the board")
+	ErrCellTaken   = errors.New("the cell is not empty")
+)
+
+// A Match struct contains the authoritative state of an online match.
+// It applies the same rules as the local Classic and GoRythm modes.
+type Match struct {
+	mode          Mode
+	beatMap       []rhythm.Beat
+	board         rules.Board
+	turn          rules.Symbol
+	queues        map[rules.Symbol]*rules.MoveQueue // The symbols of each player still on the board, oldest first
+	points        map[rules.Symbol]int
+	rounds        int
+	lastSymbol    rules.Symbol
+	lastJudgement int
+	lastOffset    float64
+	winner        rules.Symbol
+	over          bool
+}
+
+// NewMatch creates a new match with the given mode, beatmap and starting player.
+func NewMatch(mode Mode, beatMap []rhythm.Beat, starting rules.Symbol) *Match {
+	queues := map[rules.Symbol]*rules.MoveQueue{}
+	for _, player := range []rules.Symbol{rules.X, rules.O} {
+		queues[player], _ = rules.NewMoveQueue(maxSymbols)
+	}
+	return &Match{
+		mode:    mode,
+		beatMap: beatMap,
+		turn:    starting,
+		queues:  queues,
+		points:  map[rules.Symbol]int{},
+	}
+}
+
+// Play plays a move for the given player at the music time hitTime (in seconds).
+// In GoRythm mode, the oldest symbol of the player is removed when a fourth one is placed
+// and the hit is judged against the beatmap.
+func (m *Match) Play(player rules.Symbol, x, y int, hitTime float64) error {
+	if m.over {
+		return ErrMatchOver
+	}
+	if player != m.turn {
+		return ErrNotYourTurn
+	}
+	if !rules.InBounds(x, y) {
+		return ErrOutOfBoard
+	}
+	if m.board[x][y] != rules.None {
+		return ErrCellTaken
+	}
+
+	m.lastSymbol = player
+	m.lastJudgement = 0
+	m.lastOffset = 0
+	if m.mode == GoRythmMode {
+		if oldest, ok := m.queues[player].Push([2]int{x, y}); ok {
+			m.board[oldest[0]][oldest[1]] = rules.None
+		}
+		m.lastJudgement = rhythm.Judge(m.beatMap, hitTime)
+		m.lastOffset = rhythm.Offset(m.beatMap, hitTime)
+		m.points[player] += m.lastJudgement
+	}
+	m.board[x][y] = player
+	m.turn = player.Opponent()
+	m.rounds++
+
+	// Check for win and draw
+	if winner, _ := m.board.Winner(); winner != rules.None {
+		if m.mode == GoRythmMode {
+			m.points[winner] += rhythm.WinScore
+			m.winner = m.scoreWinner()
+		} else {
+			m.points[winner] += scorePerWin
+			m.winner = winner
+		}
+		m.over = true
+	} else if m.board.Full() {
+		m.over = true
+	}
+	return nil
+}
+
+// End ends the match when the music is over, the winner is the player with the most points.
+func (m *Match) End() {
+	if m.over {
+		return
+	}
+	m.winner = m.scoreWinner()
+	m.over = true
+}
+
+// Over returns whether the match is over.
+func (m *Match) Over() bool {
+	return m.over
+}
+
+// State returns the current state of the match.
+func (m *Match) State() State {
+	return State{
+		Board:         m.board,
+		Turn:          m.turn,
+		PointsX:       m.points[rules.X],
+		PointsO:       m.points[rules.O],
+		Rounds:        m.rounds,
+		NextRemovalX:  m.nextRemoval(rules.X),
+		NextRemovalO:  m.nextRemoval(rules.O),
+		QueueX:        m.queue(rules.X),
+		QueueO:        m.queue(rules.O),
+		LastSymbol:    m.lastSymbol,
+		LastJudgement: m.lastJudgement,
+		LastOffset:    m.lastOffset,
+		Winner:        m.winner,
+		Over:          m.over,
+	}
+}
+
+// nextRemoval returns the position of the symbol of the player removed on its next move,
+// or nil if the player has less than three symbols on the board.
+func (m *Match) nextRemoval(player rules.Symbol) []int {
+	queue := m.queues[player]
+	next, err := queue.Peek()
+	if !queue.Full() || err != nil {
+		return nil
+	}
+	return []int{next[0], next[1]}
+}
+
+// queue returns a copy of the symbols of the player in the order they will be removed in
+// GoRythm mode, or nil in Classic mode.
+func (m *Match) queue(player rules.Symbol) *rules.MoveQueue {
+	if m.mode != GoRythmMode {
+		return nil
+	}
+	return m.queues[player].Clone()
+}
+
+// scoreWinner returns the player with the most points, or None on a draw.
+func (m *Match) scoreWinner() rules.Symbol {
+	if m.points[rules.X] > m.points[rules.O] {
+		return rules.X
+	} else if m.points[rules.O] > m.points[rules.X] {
+		return rules.O
+	}
+	return rules.None
+}
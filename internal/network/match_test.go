@@ -0,0 +1,121 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"GoRythm/internal/rhythm"
+	"GoRythm/internal/rules"
+	"errors"
+	"testing"
+)
+
+var testBeatMap = []rhythm.Beat{
+	{Time: 1.0, BeatNum: 1},
+	{Time: 2.0, BeatNum: 2},
+	{Time: 3.0, BeatNum: 3},
+}
+
+// TestMatch_PlayErrors tests the Play function with invalid moves.
+// Checks if the moves out of turn, out of the board or on a taken cell are refused.
+func TestMatch_PlayErrors(t *testing.T) {
+	m := NewMatch(ClassicMode, nil, rules.X)
+	if err := m.Play(rules.O, 0, 0, 0); !errors.Is(err, ErrNotYourTurn) {
+		t.Errorf("Expected ErrNotYourTurn, got %v", err)
+	}
+	if err := m.Play(rules.X, 3, 0, 0); !errors.Is(err, ErrOutOfBoard) {
+		t.Errorf("Expected ErrOutOfBoard, got %v", err)
+	}
+	if err := m.Play(rules.X, 0, 0, 0); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := m.Play(rules.O, 0, 0, 0); !errors.Is(err, ErrCellTaken) {
+		t.Errorf("Expected ErrCellTaken, got %v", err)
+	}
+}
+
+// TestMatch_ClassicWin tests a Classic match won by X.
+// Checks if the match is over with X as the winner and its point.
+func TestMatch_ClassicWin(t *testing.T) {
+	m := NewMatch(ClassicMode, nil, rules.X)
+	moves := [][2]int{{0, 0}, {0, 1}, {1, 0}, {1, 1}, {2, 0}}
+	player := rules.X
+	for _, move := range moves {
+		if err := m.Play(player, move[0], move[1], 0); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		player = player.Opponent()
+	}
+	state := m.State()
+	if !state.Over || state.Winner != rules.X {
+		t.Fatalf("Expected X to win, got over=%v winner=%q", state.Over, state.Winner)
+	}
+	if state.PointsX != scorePerWin {
+		t.Errorf("Expected X to have %d point, got %d", scorePerWin, state.PointsX)
+	}
+	if err := m.Play(rules.O, 2, 2, 0); !errors.Is(err, ErrMatchOver) {
+		t.Errorf("Expected ErrMatchOver, got %v", err)
+	}
+}
+
+// TestMatch_GoRythmRemoval tests the vanishing symbols of a GoRythm match.
+// Checks if the oldest symbol is announced after the third move and removed on the fourth.
+func TestMatch_GoRythmRemoval(t *testing.T) {
+	m := NewMatch(GoRythmMode, testBeatMap, rules.X)
+	moves := [][2]int{{0, 0}, {2, 2}, {1, 0}, {2, 1}, {0, 2}, {1, 2}}
+	player := rules.X
+	for _, move := range moves {
+		if err := m.Play(player, move[0], move[1], 0); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		player = player.Opponent()
+	}
+	state := m.State()
+	if len(state.NextRemovalX) != 2 || state.NextRemovalX[0] != 0 || state.NextRemovalX[1] != 0 {
+		t.Fatalf("Expected X next removal at [0 0], got %v", state.NextRemovalX)
+	}
+
+	if err := m.Play(rules.X, 1, 1, 0); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	state = m.State()
+	if state.Board[0][0] != rules.None {
+		t.Errorf("Expected the oldest X symbol to be removed, got %q", state.Board[0][0])
+	}
+	if state.Board[1][1] != rules.X {
+		t.Errorf("Expected X at [1 1], got %q", state.Board[1][1])
+	}
+	if state.NextRemovalX[0] != 1 || state.NextRemovalX[1] != 0 {
+		t.Errorf("Expected X next removal at [1 0], got %v", state.NextRemovalX)
+	}
+	if cells := state.QueueX.Cells(); len(cells) != 3 || cells[2] != [2]int{1, 1} {
+		t.Errorf("Expected the queue of X to end with [1 1], got %v", cells)
+	}
+}
+
+// TestMatch_GoRythmScore tests the rhythm score of a GoRythm match.
+// Checks if the hits are judged against the beatmap and if the match ends on score.
+func TestMatch_GoRythmScore(t *testing.T) {
+	m := NewMatch(GoRythmMode, testBeatMap, rules.O)
+	if err := m.Play(rules.O, 0, 0, 1.02); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := m.Play(rules.X, 1, 1, 1.5); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	state := m.State()
+	if state.PointsO != rhythm.PerfectScore || state.PointsX != rhythm.MissedScore {
+		t.Fatalf("Expected O=%d and X=%d, got O=%d and X=%d", rhythm.PerfectScore, rhythm.MissedScore, state.PointsO, state.PointsX)
+	}
+	if state.LastJudgement != rhythm.MissedScore || state.LastSymbol != rules.X {
+		t.Errorf("Expected last judgement of X to be missed, got %d for %q", state.LastJudgement, state.LastSymbol)
+	}
+	if state.LastOffset != 0.5 {
+		t.Errorf("Expected last offset 0.5, got %v", state.LastOffset)
+	}
+
+	m.End()
+	if state := m.State(); !state.Over || state.Winner != rules.O {
+		t.Errorf("Expected O to win on score, got over=%v winner=%q", state.Over, state.Winner)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package network provides the online two-player matches of the game. A small server
+// is authoritative over the board, the GoRythm removal queue and the rhythm scores,
+// while the clients only send their timestamped moves and display the received state.
+//
+// The messages are JSON objects separated by new lines, sent over a TCP connection.
+package network
+
+import (
+	"GoRythm/internal/rules"
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+// A MessageType type represent the different messages exchanged with the server.
+type MessageType string
+
+const (
+	MsgHello    MessageType = "hello"     // Client -> server: join with a name
+	MsgWelcome  MessageType = "welcome"   // Server -> client: the symbol assigned to the client
+	MsgStart    MessageType = "start"     // Server -> clients: the match starts after a delay
+	MsgMove     MessageType = "move"      // Client -> server: a move with its hit time
+	MsgState    MessageType = "state"     // Server -> clients: the authoritative match state
+	MsgReject   MessageType = "reject"    // Server -> client: the last move was refused
+	MsgSongEnd  MessageType = "song_end"  // Client -> server: the music ended on the client
+	MsgGameOver MessageType = "game_over" // Server -> clients: the match is over
+	MsgLeft     MessageType = "left"      // Server -> client: the opponent disconnected
+	MsgPing     MessageType = "ping"      // Client <-> server: clock synchronisation or round-trip request
+	MsgPong     MessageType = "pong"      // Server <-> client: answer to a ping
+)
+
+// A Role type represent the role of a client in a match.
+type Role string
+
+const (
+	PlayerRole    Role = "player"    // Plays the match
+	SpectatorRole Role = "spectator" // Watches the match read-only, with a delay
+)
+
+// A Mode type represent the rules used by a match.
+type Mode string
+
+const (
+	ClassicMode Mode = "classic" // Classic Tic-Tac-Toe
+	GoRythmMode Mode = "gorythm" // Tic-Tac-Toe with vanishing symbols and rhythm scores
+)
+
+// A Message struct contains all the fields of the protocol, only the fields needed by
+// the message type are set.
+type Message struct {
+	Type    MessageType  `json:"type"`              // The message type
+	Name    string       `json:"name,omitempty"`    // The player name (hello)
+	Role    Role         `json:"role,omitempty"`    // The client role (hello, welcome)
+	Symbol  rules.Symbol `json:"symbol,omitempty"`  // The player symbol (welcome)
+	Mode    Mode         `json:"mode,omitempty"`    // The match mode (welcome, start)
+	StartIn int64        `json:"startIn,omitempty"` // The delay before the music starts in milliseconds (start)
+	StartAt int64        `json:"startAt,omitempty"` // The server time the music starts in Unix nanoseconds (start)
+	X       int          `json:"x"`                 // The move column (move)
+	Y       int          `json:"y"`                 // The move row (move)
+	HitTime float64      `json:"hitTime,omitempty"` // The music time of the move in seconds (move)
+	State   *State       `json:"state,omitempty"`   // The match state (state, game_over)
+	Reason  string       `json:"reason,omitempty"`  // The reason of a refused move (reject)
+	T0      int64        `json:"t0,omitempty"`      // The time the ping was sent in Unix nanoseconds (ping, pong)
+	T1      int64        `json:"t1,omitempty"`      // The time the ping was received in Unix nanoseconds (pong)
+	T2      int64        `json:"t2,omitempty"`      // The time the pong was sent in Unix nanoseconds (pong)
+}
+
+// A State struct contains the match state sent to the clients after each change.
+type State struct {
+	Board         rules.Board      `json:"board"`            // The board, indexed by [x][y]
+	Turn          rules.Symbol     `json:"turn"`             // The symbol of the player to play
+	PointsX       int              `json:"pointsX"`          // The points of player X
+	PointsO       int              `json:"pointsO"`          // The points of player O
+	Rounds        int              `json:"rounds"`           // The number of moves played
+	NextRemovalX  []int            `json:"nextRemovalX"`     // The next symbol of X to be removed, if any
+	NextRemovalO  []int            `json:"nextRemovalO"`     // The next symbol of O to be removed, if any
+	QueueX        *rules.MoveQueue `json:"queueX,omitempty"` // The symbols of X in the order they will be removed (GoRythm mode)
+	QueueO        *rules.MoveQueue `json:"queueO,omitempty"` // The symbols of O in the order they will be removed (GoRythm mode)
+	LastSymbol    rules.Symbol     `json:"lastSymbol"`       // The symbol of the last move
+	LastJudgement int              `json:"lastJudgement"`    // The rhythm score of the last move
+	LastOffset    float64          `json:"lastOffset"`       // The timing offset of the last move in seconds
+	Winner        rules.Symbol     `json:"winner"`           // The winner when the match is over
+	Over          bool             `json:"over"`             // Whether the match is over
+	Spectators    int              `json:"spectators"`       // The number of spectators watching the match
+}
+
+// encoder writes messages on a connection.
+type encoder struct {
+	enc *json.Encoder
+}
+
+// newEncoder creates a new encoder writing on the given writer.
+func newEncoder(w io.Writer) *encoder {
+	return &encoder{enc: json.NewEncoder(w)}
+}
+
+// send writes a message followed by a new line.
+func (e *encoder) send(msg Message) error {
+	return e.enc.Encode(msg)
+}
+
+// decoder reads messages from a connection.
+type decoder struct {
+	scanner *bufio.Scanner
+}
+
+// newDecoder creates a new decoder reading from the given reader.
+func newDecoder(r io.Reader) *decoder {
+	return &decoder{scanner: bufio.NewScanner(r)}
+}
+
+// receive reads the next message. It returns io.EOF when the connection is closed.
+func (d *decoder) receive() (Message, error) {
+	var msg Message
+	if !d.scanner.Scan() {
+		if err := d.scanner.Err(); err != nil {
+			return msg, err
+		}
+		return msg, io.EOF
+	}
+	err := json.Unmarshal(d.scanner.Bytes(), &msg)
+	return msg, err
+}
@@ -0,0 +1,467 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"GoRythm/internal/clocksync"
+	"GoRythm/internal/log"
+	"GoRythm/internal/rhythm"
+	"GoRythm/internal/rules"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	DefaultPort  = 4242             // The default port of the server
+	StartDelay   = 3 * time.Second  // The delay before the music starts, the same as the local countdown
+	helloTimeout = 10 * time.Second // The time allowed to a client to send its hello message
+	sendBuffer   = 256              // The number of messages waiting to be written to a client
+)
+
+// A Server struct accepts the clients and pairs them two by two in matches.
+type Server struct {
+	mode     Mode          // The mode of the matches hosted by the server
+	beatMap  []rhythm.Beat // The beatmap used to judge the hits in GoRythm mode
+	listener net.Listener  // The listener accepting the clients
+
+	spectatorDelay time.Duration // The delay of the messages sent to the spectators
+
+	mu       sync.Mutex     // Protects the fields below and the room of the peers
+	waiting  *peer          // The client waiting for an opponent
+	peers    map[*peer]bool // The connected clients
+	current  *room          // The latest match started, watched by the new spectators
+	watchers []*peer        // The spectators waiting for a match to start
+	closed   bool           // Whether the server is closed
+	wg       sync.WaitGroup // Waits for the clients handlers
+}
+
+// A peer struct contains a client connection and the match it plays in.
+type peer struct {
+	conn    net.Conn
+	name    string
+	role    Role
+	symbol  rules.Symbol
+	room    *room
+	rtt     *clocksync.Estimator // The round-trip delays measured by the pings of the server
+	pingAt  atomic.Int64         // The send time of the last ping in Unix nanoseconds
+	delayed chan delayedMessage  // The messages sent later to a spectator
+	queue   chan Message         // The messages waiting to be written by writeLoop
+	done    chan struct{}        // Closed when the client left, stops its goroutines
+
+	enc *encoder
+}
+
+// A room struct contains a running match, its two players and its spectators.
+type room struct {
+	mu         sync.Mutex
+	match      *Match
+	players    map[rules.Symbol]*peer
+	spectators map[*peer]bool
+	timeline   clocksync.Timeline // The music timeline on the server clock
+
+	spectatorDelay time.Duration // The delay of the messages sent to the spectators
+}
+
+// NewServer creates a new server hosting matches with the given mode and beatmap.
+func NewServer(mode Mode, beatMap []rhythm.Beat) *Server {
+	return &Server{
+		mode:    mode,
+		beatMap: beatMap,
+		peers:   map[*peer]bool{},
+
+		spectatorDelay: SpectatorDelay,
+	}
+}
+
+// Listen starts listening on the given TCP address, Serve must be called to accept clients.
+func (s *Server) Listen(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+	s.listener = listener
+	return nil
+}
+
+// Addr returns the address the server listens on.
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Port returns the TCP port the server listens on.
+func (s *Server) Port() int {
+	if addr, ok := s.listener.Addr().(*net.TCPAddr); ok {
+		return addr.Port
+	}
+	return 0
+}
+
+// Mode returns the mode of the matches hosted by the server.
+func (s *Server) Mode() Mode {
+	return s.mode
+}
+
+// Players returns the number of connected players, without the spectators.
+func (s *Server) Players() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	players := 0
+	for p := range s.peers {
+		if p.role == PlayerRole {
+			players++
+		}
+	}
+	return players
+}
+
+// Open returns true if a player joining now would start a match right away or wait alone
+// for an opponent, that is if nobody plays or a player waits for an opponent.
+func (s *Server) Open() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.waiting != nil || s.current == nil
+}
+
+// Serve accepts the clients until the server is closed.
+func (s *Server) Serve() error {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			s.mu.Lock()
+			closed := s.closed
+			s.mu.Unlock()
+			if closed {
+				return nil
+			}
+			return err
+		}
+		p := &peer{
+			conn:  conn,
+			rtt:   clocksync.NewEstimator(clocksync.DefaultWindow),
+			queue: make(chan Message, sendBuffer),
+			done:  make(chan struct{}),
+			enc:   newEncoder(conn),
+		}
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			conn.Close()
+			return nil
+		}
+		s.peers[p] = true
+		s.mu.Unlock()
+		s.wg.Add(1)
+		go s.handle(p)
+	}
+}
+
+// ListenAndServe listens on the given TCP address and accepts the clients until the server is closed.
+func (s *Server) ListenAndServe(addr string) error {
+	if err := s.Listen(addr); err != nil {
+		return err
+	}
+	return s.Serve()
+}
+
+// Close stops the server and disconnects all the clients.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	for p := range s.peers {
+		p.conn.Close()
+	}
+	s.mu.Unlock()
+	var err error
+	if s.listener != nil {
+		err = s.listener.Close()
+	}
+	s.wg.Wait()
+	return err
+}
+
+// handle reads the messages of a client until it disconnects.
+func (s *Server) handle(p *peer) {
+	defer s.wg.Done()
+	defer s.leave(p)
+	go p.writeLoop()
+
+	dec := newDecoder(p.conn)
+	p.conn.SetReadDeadline(time.Now().Add(helloTimeout))
+	msg, err := dec.receive()
+	if err != nil || msg.Type != MsgHello {
+		log.LogMessage(log.WARN, fmt.Sprintf("client %s did not say hello", p.conn.RemoteAddr()))
+		return
+	}
+	p.conn.SetReadDeadline(time.Time{})
+	p.name = msg.Name
+	if msg.Role == SpectatorRole {
+		s.watch(p)
+	} else {
+		s.join(p)
+		go p.pingLoop()
+	}
+
+	for {
+		msg, err := dec.receive()
+		if err != nil {
+			return
+		}
+		received := time.Now()
+		switch msg.Type {
+		case MsgPing:
+			p.send(Message{Type: MsgPong, T0: msg.T0, T1: received.UnixNano(), T2: time.Now().UnixNano()})
+		case MsgPong:
+			p.pong(msg, received)
+		case MsgMove:
+			s.play(p, msg, received)
+		case MsgSongEnd:
+			s.songEnd(p)
+		default:
+			log.LogMessage(log.WARN, fmt.Sprintf("unexpected message %q from %s", msg.Type, p.name))
+		}
+	}
+}
+
+// join pairs the client with the waiting one, or makes it wait for an opponent.
+func (s *Server) join(p *peer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	log.LogMessage(log.INFO, fmt.Sprintf("%s joined from %s", p.name, p.conn.RemoteAddr()))
+	p.role = PlayerRole
+
+	if s.waiting == nil {
+		p.symbol = rules.X
+		s.waiting = p
+		p.send(Message{Type: MsgWelcome, Role: p.role, Symbol: p.symbol, Mode: s.mode})
+		return
+	}
+
+	opponent := s.waiting
+	s.waiting = nil
+	p.symbol = rules.O
+	p.send(Message{Type: MsgWelcome, Role: p.role, Symbol: p.symbol, Mode: s.mode})
+
+	starting := rules.X
+	if rand.Intn(2) == 0 {
+		starting = rules.O
+	}
+	startAt := time.Now().Add(StartDelay)
+	r := &room{
+		match:      NewMatch(s.mode, s.beatMap, starting),
+		players:    map[rules.Symbol]*peer{opponent.symbol: opponent, p.symbol: p},
+		spectators: map[*peer]bool{},
+		timeline:   clocksync.NewTimeline(startAt),
+
+		spectatorDelay: s.spectatorDelay,
+	}
+	opponent.room = r
+	p.room = r
+	s.current = r
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	state := r.state()
+	r.broadcast(Message{
+		Type:    MsgStart,
+		Mode:    s.mode,
+		StartIn: StartDelay.Milliseconds(),
+		StartAt: startAt.UnixNano(),
+		State:   &state,
+	})
+
+	// The spectators waiting for a match watch this one
+	for _, watcher := range s.watchers {
+		s.attach(r, watcher)
+	}
+	s.watchers = nil
+}
+
+// play plays the move of a client in its match and sends the new state to both players.
+// The reported hit time is checked against the music timeline with the round-trip delay
+// measured by the server, an invalid one is replaced by the music time the move was received.
+func (s *Server) play(p *peer, msg Message, received time.Time) {
+	if p.role != PlayerRole {
+		p.send(Message{Type: MsgReject, Reason: "spectators cannot play"})
+		return
+	}
+	r := s.roomOf(p)
+	if r == nil {
+		p.send(Message{Type: MsgReject, Reason: "no opponent yet"})
+		return
+	}
+	defer s.forgetOver(r)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	hitTime := msg.HitTime
+	if err := r.timeline.Validate(hitTime, received, p.rtt.Delay()); err != nil {
+		log.LogMessage(log.WARN, fmt.Sprintf("invalid hit time %.3f from %s: %v", hitTime, p.name, err))
+		hitTime = r.timeline.SongTime(received)
+	}
+	if err := r.match.Play(p.symbol, msg.X, msg.Y, hitTime); err != nil {
+		p.send(Message{Type: MsgReject, Reason: err.Error()})
+		return
+	}
+	r.sendState()
+}
+
+// songEnd ends the match of a client when its music is over.
+func (s *Server) songEnd(p *peer) {
+	r := s.roomOf(p)
+	if r == nil || p.role != PlayerRole {
+		return
+	}
+	defer s.forgetOver(r)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.match.Over() {
+		return
+	}
+	r.match.End()
+	r.sendState()
+}
+
+// forgetOver forgets the match of the room once it is over, so the new spectators wait for the
+// next match instead of watching a finished one. The room must not be locked.
+func (s *Server) forgetOver(r *room) {
+	r.mu.Lock()
+	over := r.match.Over()
+	r.mu.Unlock()
+	if !over {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.current == r {
+		s.current = nil
+	}
+}
+
+// leave removes a disconnected client and warns the other clients of its match.
+func (s *Server) leave(p *peer) {
+	p.conn.Close()
+	s.mu.Lock()
+	delete(s.peers, p)
+	if s.waiting == p {
+		s.waiting = nil
+	}
+	s.removeWatcher(p)
+	r := p.room
+	if r != nil && p.role == PlayerRole && s.current == r {
+		s.current = nil
+	}
+	s.mu.Unlock()
+
+	if r != nil {
+		r.mu.Lock()
+		if p.role == SpectatorRole {
+			r.unwatch(p)
+		} else {
+			if opponent := r.players[p.symbol.Opponent()]; opponent != nil {
+				opponent.send(Message{Type: MsgLeft})
+			}
+			r.broadcastSpectators(Message{Type: MsgLeft})
+			delete(r.players, p.symbol)
+		}
+		r.mu.Unlock()
+	}
+	close(p.done)
+	if p.delayed != nil {
+		close(p.delayed)
+	}
+	log.LogMessage(log.INFO, fmt.Sprintf("%s left", p.name))
+}
+
+// roomOf returns the room of a client, or nil if it waits for an opponent.
+func (s *Server) roomOf(p *peer) *room {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return p.room
+}
+
+// state returns the state of the match with the number of spectators. The room must be locked.
+func (r *room) state() State {
+	state := r.match.State()
+	state.Spectators = len(r.spectators)
+	return state
+}
+
+// sendState sends the match state to the players and spectators, followed by the game over
+// message when the match is over. The room must be locked.
+func (r *room) sendState() {
+	state := r.state()
+	r.broadcast(Message{Type: MsgState, State: &state})
+	r.broadcastSpectators(Message{Type: MsgState, State: &state})
+	if state.Over {
+		r.broadcast(Message{Type: MsgGameOver, State: &state})
+		r.broadcastSpectators(Message{Type: MsgGameOver, State: &state})
+	}
+}
+
+// broadcast sends a message to the players of the room. The room must be locked.
+func (r *room) broadcast(msg Message) {
+	for _, p := range r.players {
+		p.send(msg)
+	}
+}
+
+// pingLoop sends the pings measuring the round-trip delay of a player until it left, with a
+// short burst right after connecting. The delay is never taken from the client, which could
+// claim a long one to backdate its hits.
+func (p *peer) pingLoop() {
+	for i := 0; ; i++ {
+		interval := syncInterval
+		if i < syncBurst {
+			interval = burstInterval
+		}
+		now := time.Now().UnixNano()
+		p.pingAt.Store(now)
+		p.send(Message{Type: MsgPing, T0: now})
+		select {
+		case <-p.done:
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// pong measures the round-trip delay of a player from the answer to the last ping. The answers
+// to older pings, or with a send time the server never used, are ignored.
+func (p *peer) pong(msg Message, received time.Time) {
+	if msg.T0 == 0 || msg.T0 != p.pingAt.Load() {
+		return
+	}
+	p.rtt.Add(clocksync.Sample{Delay: received.Sub(time.Unix(0, msg.T0)), At: received})
+}
+
+// send queues a message for the client without waiting, so a slow client cannot block the
+// others. A client too slow to empty its queue is disconnected.
+func (p *peer) send(msg Message) {
+	select {
+	case p.queue <- msg:
+	case <-p.done:
+	default:
+		log.LogMessage(log.WARN, fmt.Sprintf("disconnecting %s, too slow to receive %q", p.name, msg.Type))
+		p.conn.Close()
+	}
+}
+
+// writeLoop writes the queued messages to the client until it left, errors are handled by
+// its reading loop.
+func (p *peer) writeLoop() {
+	for {
+		select {
+		case msg := <-p.queue:
+			if err := p.enc.send(msg); err != nil && !errors.Is(err, net.ErrClosed) {
+				log.LogMessage(log.WARN, fmt.Sprintf("failed to send %q to %s: %v", msg.Type, p.name, err))
+			}
+		case <-p.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"GoRythm/internal/clocksync"
+	"GoRythm/internal/rhythm"
+	"GoRythm/internal/rules"
+	"net"
+	"testing"
+	"time"
+)
+
+const eventTimeout = 2 * time.Second
+
+// startServer starts a server on the loopback interface and closes it at the end of the test.
+func startServer(t *testing.T, mode Mode) *Server {
+	t.Helper()
+	s := NewServer(mode, testBeatMap)
+	if err := s.Listen("127.0.0.1:0"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	go s.Serve()
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+// dial connects a player to the server and closes it at the end of the test.
+func dial(t *testing.T, s *Server, name string) *Client {
+	t.Helper()
+	return dialRole(t, s, name, PlayerRole)
+}
+
+// dialRole connects a client with the given role to the server and closes it at the end of the test.
+func dialRole(t *testing.T, s *Server, name string, role Role) *Client {
+	t.Helper()
+	c, err := Dial(s.Addr(), name, role)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+// waitFor returns the next message of the given type received by the client.
+func waitFor(t *testing.T, c *Client, msgType MessageType) Message {
+	t.Helper()
+	timeout := time.After(eventTimeout)
+	for {
+		select {
+		case msg, ok := <-c.Events():
+			if !ok {
+				t.Fatalf("Connection closed while waiting for %q", msgType)
+			}
+			if msg.Type == msgType {
+				return msg
+			}
+		case <-timeout:
+			t.Fatalf("Timeout while waiting for %q", msgType)
+		}
+	}
+}
+
+// TestServer_Match tests a whole Classic match between two clients on the loopback interface.
+// Checks if the symbols are assigned, the moves are shared and refused out of turn,
+// and if both clients receive the game over.
+func TestServer_Match(t *testing.T) {
+	s := startServer(t, ClassicMode)
+	c1 := dial(t, s, "alice")
+	c2 := dial(t, s, "bob")
+	if c1.Symbol() != rules.X || c2.Symbol() != rules.O {
+		t.Fatalf("Expected symbols X and O, got %q and %q", c1.Symbol(), c2.Symbol())
+	}
+	if c1.Mode() != ClassicMode {
+		t.Errorf("Expected mode %q, got %q", ClassicMode, c1.Mode())
+	}
+
+	start := waitFor(t, c1, MsgStart)
+	waitFor(t, c2, MsgStart)
+	if start.StartIn != StartDelay.Milliseconds() {
+		t.Errorf("Expected start in %d ms, got %d", StartDelay.Milliseconds(), start.StartIn)
+	}
+
+	clients := map[rules.Symbol]*Client{rules.X: c1, rules.O: c2}
+	first := clients[start.State.Turn]
+	second := clients[start.State.Turn.Opponent()]
+
+	// Out of turn
+	if err := second.SendMove(0, 0, 0); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	waitFor(t, second, MsgReject)
+
+	moves := [][2]int{{0, 0}, {0, 1}, {1, 0}, {1, 1}, {2, 0}}
+	for i, move := range moves {
+		player := first
+		if i%2 == 1 {
+			player = second
+		}
+		if err := player.SendMove(move[0], move[1], 0); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		state := waitFor(t, second, MsgState).State
+		waitFor(t, first, MsgState)
+		if state.Board[move[0]][move[1]] != player.Symbol() {
+			t.Fatalf("Expected %q at %v, got %q", player.Symbol(), move, state.Board[move[0]][move[1]])
+		}
+	}
+
+	over := waitFor(t, c1, MsgGameOver)
+	waitFor(t, c2, MsgGameOver)
+	if over.State.Winner != first.Symbol() {
+		t.Errorf("Expected %q to win, got %q", first.Symbol(), over.State.Winner)
+	}
+}
+
+// TestServer_OpponentLeft tests the disconnection of a player during a match.
+// Checks if the opponent is warned.
+func TestServer_OpponentLeft(t *testing.T) {
+	s := startServer(t, GoRythmMode)
+	c1 := dial(t, s, "alice")
+	c2 := dial(t, s, "bob")
+	waitFor(t, c1, MsgStart)
+	c2.Close()
+	waitFor(t, c1, MsgLeft)
+}
+
+// TestServer_InvalidHitTime tests the validation of the hit times by the server.
+// Checks if a hit reported on a beat that has not happened yet is judged when it was received.
+func TestServer_InvalidHitTime(t *testing.T) {
+	s := startServer(t, GoRythmMode)
+	c1 := dial(t, s, "alice")
+	c2 := dial(t, s, "bob")
+	start := waitFor(t, c1, MsgStart)
+	clients := map[rules.Symbol]*Client{c1.Symbol(): c1, c2.Symbol(): c2}
+	first := clients[start.State.Turn]
+
+	// The music starts in 3 seconds, a perfect hit on the first beat is impossible
+	if err := first.SendMove(1, 1, testBeatMap[0].Time); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	state := waitFor(t, first, MsgState).State
+	if state.LastJudgement != rhythm.MissedScore {
+		t.Errorf("Expected the hit to be missed, got %d", state.LastJudgement)
+	}
+}
+
+// TestClient_ClockSync tests the clock synchronisation of a client.
+// Checks if the client is synchronised shortly after connecting, without offset on loopback.
+func TestClient_ClockSync(t *testing.T) {
+	s := startServer(t, ClassicMode)
+	c := dial(t, s, "alice")
+	deadline := time.Now().Add(eventTimeout)
+	for !c.Synced() {
+		if time.Now().After(deadline) {
+			t.Fatal("Timeout while waiting for the clock synchronisation")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	now := time.Now()
+	if offset := c.ServerTime(now).Sub(now); offset > 5*time.Millisecond || offset < -5*time.Millisecond {
+		t.Errorf("Expected no offset on loopback, got %v", offset)
+	}
+}
+
+// TestServer_Info tests the Players and Open functions used to announce the server.
+// Checks if the server is open until two players play a match.
+func TestServer_Info(t *testing.T) {
+	s := startServer(t, ClassicMode)
+	if s.Players() != 0 || !s.Open() {
+		t.Fatalf("Expected an open server without players, got %d players", s.Players())
+	}
+	c1 := dial(t, s, "alice")
+	dialRole(t, s, "carol", SpectatorRole)
+	if s.Players() != 1 || !s.Open() {
+		t.Fatalf("Expected an open server with 1 player, got %d players", s.Players())
+	}
+	dial(t, s, "bob")
+	waitFor(t, c1, MsgStart)
+	if s.Players() != 2 || s.Open() {
+		t.Errorf("Expected a full server with 2 players, got %d players", s.Players())
+	}
+}
+
+// TestPeer_send tests the sending queue of a client which does not read its messages.
+// Checks if sending never blocks and if the client is disconnected once its queue is full.
+func TestPeer_send(t *testing.T) {
+	conn, other := net.Pipe()
+	defer other.Close()
+	p := &peer{conn: conn, name: "alice", queue: make(chan Message, sendBuffer), done: make(chan struct{}), enc: newEncoder(conn)}
+	go p.writeLoop()
+	defer close(p.done)
+
+	sent := make(chan struct{})
+	go func() {
+		for i := 0; i <= sendBuffer+1; i++ {
+			p.send(Message{Type: MsgState})
+		}
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(eventTimeout):
+		t.Fatal("Expected the messages to be queued without blocking")
+	}
+	if _, err := conn.Write([]byte("\n")); err == nil {
+		t.Error("Expected the slow client to be disconnected")
+	}
+}
+
+// TestPeer_pong tests the round-trip delay measured by the server.
+// Checks if only the answer to the last ping is measured, from the server clock.
+func TestPeer_pong(t *testing.T) {
+	p := &peer{rtt: clocksync.NewEstimator(clocksync.DefaultWindow)}
+	sent := time.Now()
+	p.pingAt.Store(sent.UnixNano())
+
+	// An answer to a ping the server did not send, claiming a long delay
+	p.pong(Message{Type: MsgPong, T0: sent.Add(-time.Second).UnixNano()}, sent.Add(20*time.Millisecond))
+	if p.rtt.Synced() {
+		t.Fatalf("Expected the forged answer to be ignored, got the delay %v", p.rtt.Delay())
+	}
+	p.pong(Message{Type: MsgPong, T0: sent.UnixNano()}, sent.Add(20*time.Millisecond))
+	if delay := p.rtt.Delay(); delay != 20*time.Millisecond {
+		t.Errorf("Expected the delay 20ms, got %v", delay)
+	}
+}
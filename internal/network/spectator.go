@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"GoRythm/internal/log"
+	"fmt"
+	"time"
+)
+
+const (
+	SpectatorDelay = 2 * time.Second // The delay of the messages sent to the spectators
+	delayedBuffer  = 256             // The number of messages waiting to be sent to a spectator
+)
+
+// A delayedMessage struct contains a message and the time to send it.
+type delayedMessage struct {
+	msg Message
+	at  time.Time
+}
+
+// watch makes a spectator watch the latest match, or wait for the next one.
+func (s *Server) watch(p *peer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	log.LogMessage(log.INFO, fmt.Sprintf("%s watches from %s", p.name, p.conn.RemoteAddr()))
+	p.role = SpectatorRole
+
+	p.delayed = make(chan delayedMessage, delayedBuffer)
+	go p.delayLoop()
+	p.send(Message{Type: MsgWelcome, Role: p.role, Mode: s.mode})
+
+	if r := s.current; r != nil {
+		r.mu.Lock()
+		s.attach(r, p)
+		r.mu.Unlock()
+		return
+	}
+	s.watchers = append(s.watchers, p)
+}
+
+// attach adds a spectator to a match. The music timeline sent to the spectator is shifted by
+// the spectator delay, and the current state is sent after the delay like the next ones, so the
+// beat circle matches the delayed moves. A match ending while the spectator attached is
+// followed by its game over. The server and the room must be locked.
+func (s *Server) attach(r *room, p *peer) {
+	p.room = r
+	r.spectators[p] = true
+
+	state := r.state()
+	at := time.Now().Add(r.spectatorDelay)
+	r.sendSpectator(p, Message{
+		Type:    MsgStart,
+		Mode:    s.mode,
+		StartIn: time.Until(r.timeline.Start).Milliseconds(),
+		StartAt: r.timeline.Start.Add(r.spectatorDelay).UnixNano(),
+		State:   &state,
+	}, at)
+	if state.Over {
+		r.sendSpectator(p, Message{Type: MsgGameOver, State: &state}, at)
+	}
+	r.broadcast(Message{Type: MsgState, State: &state})
+}
+
+// removeWatcher removes a spectator from the ones waiting for a match. The server must be locked.
+func (s *Server) removeWatcher(p *peer) {
+	for i, watcher := range s.watchers {
+		if watcher == p {
+			s.watchers = append(s.watchers[:i], s.watchers[i+1:]...)
+			return
+		}
+	}
+}
+
+// unwatch removes a spectator from a match and sends the new number of spectators to the
+// players. The room must be locked.
+func (r *room) unwatch(p *peer) {
+	delete(r.spectators, p)
+	state := r.state()
+	r.broadcast(Message{Type: MsgState, State: &state})
+}
+
+// broadcastSpectators sends a message to the spectators of the room after the spectator delay.
+// The room must be locked.
+func (r *room) broadcastSpectators(msg Message) {
+	at := time.Now().Add(r.spectatorDelay)
+	for p := range r.spectators {
+		r.sendSpectator(p, msg, at)
+	}
+}
+
+// sendSpectator queues a message sent to a spectator at the given time. The room must be locked.
+func (r *room) sendSpectator(p *peer, msg Message, at time.Time) {
+	select {
+	case p.delayed <- delayedMessage{msg: msg, at: at}:
+	default:
+		log.LogMessage(log.WARN, fmt.Sprintf("dropped %q for the spectator %s", msg.Type, p.name))
+	}
+}
+
+// delayLoop sends the delayed messages of a spectator in order, at their time.
+func (p *peer) delayLoop() {
+	for dm := range p.delayed {
+		time.Sleep(time.Until(dm.at))
+		p.send(dm.msg)
+	}
+}
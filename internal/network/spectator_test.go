@@ -0,0 +1,130 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"GoRythm/internal/rules"
+	"testing"
+	"time"
+)
+
+const testSpectatorDelay = 200 * time.Millisecond
+
+// TestServer_Spectator tests a spectator waiting for a match then watching it.
+// Checks if the spectator timeline is shifted, if the players see the spectator count
+// and if the moves reach the spectator after the delay.
+func TestServer_Spectator(t *testing.T) {
+	s := startServer(t, GoRythmMode)
+	s.spectatorDelay = testSpectatorDelay
+	spectator := dialRole(t, s, "carol", SpectatorRole)
+	if spectator.Role() != SpectatorRole || spectator.Symbol() != rules.None {
+		t.Fatalf("Expected a spectator without symbol, got %q and %q", spectator.Role(), spectator.Symbol())
+	}
+
+	c1 := dial(t, s, "alice")
+	c2 := dial(t, s, "bob")
+	start := waitFor(t, c1, MsgStart)
+	spectatorStart := waitFor(t, spectator, MsgStart)
+	if shift := time.Duration(spectatorStart.StartAt - start.StartAt); shift != testSpectatorDelay {
+		t.Errorf("Expected the spectator timeline shifted by %v, got %v", testSpectatorDelay, shift)
+	}
+	if count := waitFor(t, c1, MsgState).State.Spectators; count != 1 {
+		t.Errorf("Expected 1 spectator, got %d", count)
+	}
+
+	clients := map[rules.Symbol]*Client{c1.Symbol(): c1, c2.Symbol(): c2}
+	first := clients[start.State.Turn]
+	if err := first.SendMove(1, 1, 0); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	waitFor(t, first, MsgState)
+	played := time.Now()
+	state := waitFor(t, spectator, MsgState).State
+	if elapsed := time.Since(played); elapsed < testSpectatorDelay/2 {
+		t.Errorf("Expected the move to be delayed for the spectator, got it after %v", elapsed)
+	}
+	if state.Board[1][1] != first.Symbol() {
+		t.Errorf("Expected %q at [1 1], got %q", first.Symbol(), state.Board[1][1])
+	}
+
+	// Spectators cannot play
+	if err := spectator.SendMove(0, 0, 0); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	waitFor(t, spectator, MsgReject)
+
+	// The players receive the new count, after the states of the move
+	spectator.Close()
+	state = waitFor(t, c1, MsgState).State
+	for state.Spectators != 0 {
+		state = waitFor(t, c1, MsgState).State
+	}
+}
+
+// TestServer_SpectatorJoinsRunningMatch tests a spectator joining a running match.
+// Checks if the spectator receives the current board after the delay, like the next moves.
+func TestServer_SpectatorJoinsRunningMatch(t *testing.T) {
+	s := startServer(t, ClassicMode)
+	s.spectatorDelay = testSpectatorDelay
+	c1 := dial(t, s, "alice")
+	c2 := dial(t, s, "bob")
+	start := waitFor(t, c1, MsgStart)
+	clients := map[rules.Symbol]*Client{c1.Symbol(): c1, c2.Symbol(): c2}
+	first := clients[start.State.Turn]
+	if err := first.SendMove(2, 0, 0); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	waitFor(t, first, MsgState)
+
+	joined := time.Now()
+	spectator := dialRole(t, s, "carol", SpectatorRole)
+	state := waitFor(t, spectator, MsgStart).State
+	if elapsed := time.Since(joined); elapsed < testSpectatorDelay/2 {
+		t.Errorf("Expected the board to be delayed for the spectator, got it after %v", elapsed)
+	}
+	if state.Board[2][0] != first.Symbol() {
+		t.Errorf("Expected %q at [2 0], got %q", first.Symbol(), state.Board[2][0])
+	}
+}
+
+// TestServer_SpectatorAfterMatch tests a spectator connecting after the end of a match.
+// Checks if the spectator waits for the next match instead of watching the finished one.
+func TestServer_SpectatorAfterMatch(t *testing.T) {
+	s := startServer(t, ClassicMode)
+	s.spectatorDelay = testSpectatorDelay
+	c1 := dial(t, s, "alice")
+	c2 := dial(t, s, "bob")
+	start := waitFor(t, c1, MsgStart)
+	clients := map[rules.Symbol]*Client{c1.Symbol(): c1, c2.Symbol(): c2}
+	first := clients[start.State.Turn]
+	second := clients[start.State.Turn.Opponent()]
+	for i, move := range [][2]int{{0, 0}, {0, 1}, {1, 0}, {1, 1}, {2, 0}} {
+		player := first
+		if i%2 == 1 {
+			player = second
+		}
+		if err := player.SendMove(move[0], move[1], 0); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		waitFor(t, first, MsgState)
+		waitFor(t, second, MsgState)
+	}
+	waitFor(t, c1, MsgGameOver)
+	if !s.Open() {
+		t.Error("Expected the server to be open after the match")
+	}
+
+	spectator := dialRole(t, s, "carol", SpectatorRole)
+	select {
+	case msg := <-spectator.Events():
+		t.Errorf("Expected the spectator to wait for the next match, got %q", msg.Type)
+	case <-time.After(2 * testSpectatorDelay):
+	}
+	dial(t, s, "dave")
+	c3 := dial(t, s, "erin")
+	waitFor(t, c3, MsgStart)
+	if state := waitFor(t, spectator, MsgStart).State; state.Rounds != 0 {
+		t.Errorf("Expected the spectator to watch the new match, got %d moves", state.Rounds)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package profiles
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// ExportJSON writes all the profiles with their statistics as an indented JSON array,
+// sorted by name.
+func (s *Store) ExportJSON(w io.Writer) error {
+	profiles := make([]*Profile, 0, len(s.Profiles))
+	for _, name := range s.Names() {
+		profiles = append(profiles, s.Profiles[name])
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(profiles)
+}
+
+// ExportCSV writes one CSV row of statistics per profile, sorted by name. The records against
+// each AI level seen in the profiles get their own wins, losses and draws columns.
+func (s *Store) ExportCSV(w io.Writer) error {
+	levelSet := map[string]bool{}
+	for _, p := range s.Profiles {
+		for level := range p.VsAI {
+			levelSet[level] = true
+		}
+	}
+	levels := make([]string, 0, len(levelSet))
+	for level := range levelSet {
+		levels = append(levels, level)
+	}
+	sort.Strings(levels)
+
+	header := []string{"name", "games", "wins", "losses", "draws", "pvp_wins", "pvp_losses", "pvp_draws"}
+	for _, level := range levels {
+		header = append(header, level+"_wins", level+"_losses", level+"_draws")
+	}
+	header = append(header, "hits", "beats_hit", "average_offset_ms", "older_accuracy", "recent_accuracy")
+
+	out := csv.NewWriter(w)
+	if err := out.Write(header); err != nil {
+		return err
+	}
+	for _, name := range s.Names() {
+		p := s.Profiles[name]
+		total := p.Total()
+		var pvp Record
+		for _, r := range p.PvP {
+			pvp.Wins += r.Wins
+			pvp.Losses += r.Losses
+			pvp.Draws += r.Draws
+		}
+		row := []string{p.Name, strconv.Itoa(total.Games()), strconv.Itoa(total.Wins), strconv.Itoa(total.Losses),
+			strconv.Itoa(total.Draws), strconv.Itoa(pvp.Wins), strconv.Itoa(pvp.Losses), strconv.Itoa(pvp.Draws)}
+		for _, level := range levels {
+			r := p.VsAI[level]
+			row = append(row, strconv.Itoa(r.Wins), strconv.Itoa(r.Losses), strconv.Itoa(r.Draws))
+		}
+		older, recent := p.AccuracyTrend()
+		row = append(row, strconv.Itoa(p.Hits), strconv.Itoa(p.BeatsHit), fmt.Sprintf("%.1f", p.AverageOffset()*1000),
+			fmt.Sprintf("%.1f", older), fmt.Sprintf("%.1f", recent))
+		if err := out.Write(row); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
@@ -0,0 +1,264 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package profiles provides the named player profiles of GoRythm and their lifetime
+// statistics: records against each AI level and between profiles, beats hit, timing offset
+// and accuracy trend. The profiles are saved in the persistent storage and can be exported
+// as JSON or CSV.
+package profiles
+
+import (
+	"GoRythm/internal/storage"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	fileName      = "profiles.json" // The name of the profiles in the storage
+	TrendLength   = 20              // The number of accuracies kept in the accuracy trend
+	maxNameLength = 20              // The maximum length of a profile name
+)
+
+var (
+	ErrInvalidName = errors.New("invalid profile name")
+	ErrNameTaken   = errors.New("profile name already taken")
+	ErrNoProfile   = errors.New("no such profile")
+)
+
+// An Outcome type represent the outcome of a game for a player.
+type Outcome int
+
+const (
+	Loss Outcome = iota
+	Draw
+	Win
+)
+
+// A Record struct contains the number of wins, losses and draws against an opponent.
+type Record struct {
+	Wins   int `json:"wins"`
+	Losses int `json:"losses"`
+	Draws  int `json:"draws"`
+}
+
+// Add counts a game with the given outcome.
+func (r *Record) Add(outcome Outcome) {
+	switch outcome {
+	case Win:
+		r.Wins++
+	case Loss:
+		r.Losses++
+	default:
+		r.Draws++
+	}
+}
+
+// Games returns the number of games of the record.
+func (r Record) Games() int {
+	return r.Wins + r.Losses + r.Draws
+}
+
+// An AccuracyPoint struct contains the accuracy of a game of the accuracy trend.
+type AccuracyPoint struct {
+	Date     time.Time `json:"date"`
+	Accuracy float64   `json:"accuracy"` // The accuracy of the hits (in percent)
+}
+
+// A Game struct contains the result of a game of a profile, used to update its statistics.
+type Game struct {
+	Opponent  string    // The AI level or the name of the opposing profile
+	AI        bool      // Whether the opponent is an AI
+	Outcome   Outcome   // The outcome of the game for the profile
+	Hits      int       // The number of judged hits (0 without rhythm)
+	BeatsHit  int       // The number of hits on the beat (not missed)
+	OffsetSum float64   // The sum of the timing offsets of the hits (in seconds)
+	Accuracy  float64   // The accuracy of the hits (in percent)
+	Date      time.Time // The end of the game
+}
+
+// A Profile struct contains the lifetime statistics of a player.
+type Profile struct {
+	Name      string            `json:"name"`
+	Created   time.Time         `json:"created"`
+	VsAI      map[string]Record `json:"vsAI"`      // The records by AI level
+	PvP       map[string]Record `json:"pvp"`       // The records by opposing profile
+	Hits      int               `json:"hits"`      // The number of judged hits
+	BeatsHit  int               `json:"beatsHit"`  // The number of hits on the beat
+	OffsetSum float64           `json:"offsetSum"` // The sum of the timing offsets (in seconds)
+	Trend     []AccuracyPoint   `json:"trend"`     // The accuracy of the last rhythm games, oldest first
+}
+
+// newProfile creates a profile without statistics.
+func newProfile(name string, created time.Time) *Profile {
+	return &Profile{
+		Name:    name,
+		Created: created,
+		VsAI:    map[string]Record{},
+		PvP:     map[string]Record{},
+	}
+}
+
+// AddGame updates the statistics of the profile with the result of a game.
+func (p *Profile) AddGame(g Game) {
+	records := p.PvP
+	if g.AI {
+		records = p.VsAI
+	}
+	record := records[g.Opponent]
+	record.Add(g.Outcome)
+	records[g.Opponent] = record
+
+	if g.Hits > 0 {
+		p.Hits += g.Hits
+		p.BeatsHit += g.BeatsHit
+		p.OffsetSum += g.OffsetSum
+		p.Trend = append(p.Trend, AccuracyPoint{Date: g.Date, Accuracy: g.Accuracy})
+		if len(p.Trend) > TrendLength {
+			p.Trend = p.Trend[len(p.Trend)-TrendLength:]
+		}
+	}
+}
+
+// Total returns the record of the profile against all the opponents.
+func (p *Profile) Total() Record {
+	var total Record
+	for _, records := range []map[string]Record{p.VsAI, p.PvP} {
+		for _, r := range records {
+			total.Wins += r.Wins
+			total.Losses += r.Losses
+			total.Draws += r.Draws
+		}
+	}
+	return total
+}
+
+// AverageOffset returns the average timing offset of the hits (in seconds), or 0 without hits.
+func (p *Profile) AverageOffset() float64 {
+	if p.Hits == 0 {
+		return 0
+	}
+	return p.OffsetSum / float64(p.Hits)
+}
+
+// AccuracyTrend returns the average accuracy of the older and of the recent half of the trend.
+// Both are the same with less than two games.
+func (p *Profile) AccuracyTrend() (older, recent float64) {
+	if len(p.Trend) == 0 {
+		return 0, 0
+	}
+	half := len(p.Trend) / 2
+	if half == 0 {
+		return p.Trend[0].Accuracy, p.Trend[0].Accuracy
+	}
+	return averageAccuracy(p.Trend[:half]), averageAccuracy(p.Trend[half:])
+}
+
+// averageAccuracy returns the average accuracy of the given games.
+func averageAccuracy(points []AccuracyPoint) float64 {
+	sum := 0.0
+	for _, point := range points {
+		sum += point.Accuracy
+	}
+	return sum / float64(len(points))
+}
+
+// A Store struct contains all the profiles and the profiles selected for the local players.
+type Store struct {
+	Profiles map[string]*Profile `json:"profiles"` // The profiles by name
+	Player1  string              `json:"player1"`  // The profile of the first local player
+	Player2  string              `json:"player2"`  // The profile of the second local player
+}
+
+// NewStore creates a store without profiles.
+func NewStore() *Store {
+	return &Store{Profiles: map[string]*Profile{}}
+}
+
+// Load returns the saved profiles, or a store without profiles if none were saved.
+func Load() (*Store, error) {
+	s := NewStore()
+	data, err := storage.Load(fileName)
+	if errors.Is(err, storage.ErrNotFound) {
+		return s, nil
+	}
+	if err != nil {
+		return s, err
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return NewStore(), fmt.Errorf("failed to parse %s: %w", fileName, err)
+	}
+	if s.Profiles == nil {
+		s.Profiles = map[string]*Profile{}
+	}
+	for name, p := range s.Profiles {
+		// A null profile written by hand is dropped rather than crashing the game
+		if p == nil {
+			s.Delete(name)
+			continue
+		}
+		if p.VsAI == nil {
+			p.VsAI = map[string]Record{}
+		}
+		if p.PvP == nil {
+			p.PvP = map[string]Record{}
+		}
+	}
+	return s, nil
+}
+
+// Save saves the profiles in the persistent storage.
+func (s *Store) Save() error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return storage.Save(fileName, data)
+}
+
+// Create creates a new profile with the given name.
+func (s *Store) Create(name string, created time.Time) (*Profile, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > maxNameLength {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+	if _, ok := s.Profiles[name]; ok {
+		return nil, fmt.Errorf("%w: %s", ErrNameTaken, name)
+	}
+	p := newProfile(name, created)
+	s.Profiles[name] = p
+	return p, nil
+}
+
+// Get returns the profile with the given name, or nil.
+func (s *Store) Get(name string) *Profile {
+	return s.Profiles[name]
+}
+
+// Delete deletes the profile with the given name and unselects it.
+func (s *Store) Delete(name string) error {
+	if _, ok := s.Profiles[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrNoProfile, name)
+	}
+	delete(s.Profiles, name)
+	if s.Player1 == name {
+		s.Player1 = ""
+	}
+	if s.Player2 == name {
+		s.Player2 = ""
+	}
+	return nil
+}
+
+// Names returns the names of the profiles, sorted.
+func (s *Store) Names() []string {
+	names := make([]string, 0, len(s.Profiles))
+	for name := range s.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
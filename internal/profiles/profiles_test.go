@@ -0,0 +1,180 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package profiles
+
+import (
+	"GoRythm/internal/storage"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var date = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// newTestStore creates a store with the profiles alice and bob.
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+	s := NewStore()
+	for _, name := range []string{"bob", "alice"} {
+		if _, err := s.Create(name, date); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	return s
+}
+
+// TestStore_Create tests the Create function.
+// Checks if the invalid and taken names are refused.
+func TestStore_Create(t *testing.T) {
+	s := newTestStore(t)
+	if _, err := s.Create("alice", date); !errors.Is(err, ErrNameTaken) {
+		t.Errorf("Expected ErrNameTaken, got %v", err)
+	}
+	if _, err := s.Create("  ", date); !errors.Is(err, ErrInvalidName) {
+		t.Errorf("Expected ErrInvalidName, got %v", err)
+	}
+	if names := s.Names(); len(names) != 2 || names[0] != "alice" {
+		t.Errorf("Expected alice and bob, got %v", names)
+	}
+}
+
+// TestStore_Delete tests the Delete function.
+// Checks if a selected profile is unselected.
+func TestStore_Delete(t *testing.T) {
+	s := newTestStore(t)
+	s.Player1 = "alice"
+	if err := s.Delete("alice"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if s.Player1 != "" || s.Get("alice") != nil {
+		t.Error("Expected alice to be deleted and unselected")
+	}
+	if err := s.Delete("alice"); !errors.Is(err, ErrNoProfile) {
+		t.Errorf("Expected ErrNoProfile, got %v", err)
+	}
+}
+
+// TestProfile_AddGame tests the AddGame function.
+// Checks if the records, hits, timing offset and accuracy trend are updated.
+func TestProfile_AddGame(t *testing.T) {
+	p := newTestStore(t).Get("alice")
+	p.AddGame(Game{Opponent: "hard", AI: true, Outcome: Loss, Date: date})
+	p.AddGame(Game{Opponent: "bob", Outcome: Win, Hits: 4, BeatsHit: 3, OffsetSum: 0.2, Accuracy: 60, Date: date})
+	p.AddGame(Game{Opponent: "bob", Outcome: Draw, Hits: 4, BeatsHit: 4, OffsetSum: -0.1, Accuracy: 80, Date: date})
+
+	if p.VsAI["hard"] != (Record{Losses: 1}) {
+		t.Errorf("Expected 1 loss against hard, got %v", p.VsAI["hard"])
+	}
+	if p.PvP["bob"] != (Record{Wins: 1, Draws: 1}) {
+		t.Errorf("Expected 1 win and 1 draw against bob, got %v", p.PvP["bob"])
+	}
+	if total := p.Total(); total.Games() != 3 {
+		t.Errorf("Expected 3 games, got %d", total.Games())
+	}
+	if p.Hits != 8 || p.BeatsHit != 7 {
+		t.Errorf("Expected 8 hits and 7 beats hit, got %d and %d", p.Hits, p.BeatsHit)
+	}
+	if offset := p.AverageOffset(); offset < 0.0124 || offset > 0.0126 {
+		t.Errorf("Expected average offset 0.0125, got %v", offset)
+	}
+	if older, recent := p.AccuracyTrend(); older != 60 || recent != 80 {
+		t.Errorf("Expected accuracy trend from 60 to 80, got %v to %v", older, recent)
+	}
+}
+
+// TestProfile_AddGame_trend tests the length of the accuracy trend.
+// Checks if only the TrendLength last games are kept.
+func TestProfile_AddGame_trend(t *testing.T) {
+	p := newTestStore(t).Get("alice")
+	for i := 0; i < TrendLength+5; i++ {
+		p.AddGame(Game{Opponent: "easy", AI: true, Outcome: Win, Hits: 1, Accuracy: float64(i), Date: date})
+	}
+	if len(p.Trend) != TrendLength || p.Trend[0].Accuracy != 5 {
+		t.Errorf("Expected the %d last games, got %d starting at %v", TrendLength, len(p.Trend), p.Trend[0].Accuracy)
+	}
+}
+
+// TestStore_Save tests the Save and Load functions in a temporary directory.
+// Checks if the profiles and the selected players are loaded back.
+func TestStore_Save(t *testing.T) {
+	storage.SetDir(t.TempDir())
+	defer storage.SetDir("")
+
+	s := newTestStore(t)
+	s.Player1 = "alice"
+	s.Get("alice").AddGame(Game{Opponent: "easy", AI: true, Outcome: Win, Date: date})
+	if err := s.Save(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if loaded.Player1 != "alice" || loaded.Get("alice").VsAI["easy"].Wins != 1 {
+		t.Errorf("Expected the profiles to be loaded back, got %v", loaded)
+	}
+}
+
+// TestLoad_nullProfile tests the Load function with a profile set to null in the file.
+// Checks if the null profile is dropped and unselected, and the other profiles loaded.
+func TestLoad_nullProfile(t *testing.T) {
+	storage.SetDir(t.TempDir())
+	defer storage.SetDir("")
+
+	data := `{"profiles": {"alice": null, "bob": {"name": "bob"}}, "player1": "alice", "player2": "bob"}`
+	if err := storage.Save(fileName, []byte(data)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(loaded.Profiles) != 1 || loaded.Get("bob") == nil || loaded.Player1 != "" || loaded.Player2 != "bob" {
+		t.Errorf("Expected only bob to be loaded and selected, got %+v", loaded)
+	}
+	if loaded.Get("bob").VsAI == nil {
+		t.Error("Expected the records of bob to be created")
+	}
+}
+
+// TestStore_ExportJSON tests the ExportJSON function.
+// Checks if the profiles are exported as a JSON array sorted by name.
+func TestStore_ExportJSON(t *testing.T) {
+	s := newTestStore(t)
+	var buffer bytes.Buffer
+	if err := s.ExportJSON(&buffer); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	var exported []Profile
+	if err := json.Unmarshal(buffer.Bytes(), &exported); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(exported) != 2 || exported[0].Name != "alice" {
+		t.Errorf("Expected alice then bob, got %v", exported)
+	}
+}
+
+// TestStore_ExportCSV tests the ExportCSV function.
+// Checks if the header has the columns of each AI level and if there is one row per profile.
+func TestStore_ExportCSV(t *testing.T) {
+	s := newTestStore(t)
+	s.Get("alice").AddGame(Game{Opponent: "hard", AI: true, Outcome: Win, Hits: 2, BeatsHit: 2, OffsetSum: 0.01, Accuracy: 100, Date: date})
+	var buffer bytes.Buffer
+	if err := s.ExportCSV(&buffer); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected a header and 2 rows, got %d lines", len(lines))
+	}
+	if !strings.Contains(lines[0], "hard_wins,hard_losses,hard_draws") {
+		t.Errorf("Expected the columns of the hard level, got %s", lines[0])
+	}
+	if lines[1] != "alice,1,1,0,0,0,0,0,1,0,0,2,2,5.0,100.0,100.0" {
+		t.Errorf("Expected the statistics of alice, got %s", lines[1])
+	}
+}
@@ -1,13 +1,20 @@
 // Copyright (c) 2025 Elian Waeber & Valentin Roch
 // SPDX-License-Identifier: Apache-2.0
 
-package audio
+// Package rhythm provides the beatmap of the GoRythm music and the judgement of the
+// players hits against it. It does not depend on the audio backend so it can be used
+// by the headless servers.
+package rhythm
 
 import (
 	_ "embed"
 	"encoding/json"
 )
 
+const (
+	SongName = "Track 1" // The name of the music of the beatmap
+)
+
 // A Beat struct contains the time and beat number of a beat
 type Beat struct {
 	Time    float64 `json:"time"`    // The time of the beat
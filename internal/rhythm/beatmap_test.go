@@ -1,7 +1,7 @@
 // Copyright (c) 2025 Elian Waeber & Valentin Roch
 // SPDX-License-Identifier: Apache-2.0
 
-package audio
+package rhythm
 
 import (
 	"testing"
@@ -0,0 +1,69 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package rhythm
+
+import "math"
+
+const (
+	MissedScore  = 0    // Score when missing a beat
+	PerfectScore = 300  // Score when hitting a beat perfectly
+	GoodScore    = 100  // Score when hitting a beat well
+	OkScore      = 50   // Score when hitting a beat ok
+	PerfectPrec  = 0.1  // Precision for perfect score (in seconds)
+	GoodPrec     = 0.25 // Precision for good score (in seconds)
+	OkPrec       = 0.4  // Precision for ok score (in seconds)
+	WinScore     = 250  // The score per win in GoRythm mode
+)
+
+// ClosestBeat returns the time of the beat closest to the elapsed time (in seconds).
+func ClosestBeat(beatMap []Beat, elapsed float64) float64 {
+	var closestBeatTime float64
+	minDifference := math.MaxFloat64
+	for _, beat := range beatMap {
+		difference := math.Abs(beat.Time - elapsed)
+		if difference < minDifference {
+			minDifference = difference
+			closestBeatTime = beat.Time
+		}
+	}
+	return closestBeatTime
+}
+
+// Score returns the score of a hit based on its time difference (in seconds) with a beat.
+func Score(difference float64) int {
+	difference = math.Abs(difference)
+	if difference < PerfectPrec {
+		return PerfectScore
+	} else if difference < GoodPrec {
+		return GoodScore
+	} else if difference < OkPrec {
+		return OkScore
+	}
+	return MissedScore
+}
+
+// Label returns the judgement name of a hit score.
+func Label(score int) string {
+	switch {
+	case score >= PerfectScore:
+		return "Perfect"
+	case score >= GoodScore:
+		return "Good"
+	case score >= OkScore:
+		return "OK"
+	}
+	return "Miss"
+}
+
+// Judge returns the score of a hit made at the elapsed time (in seconds) of the music,
+// based on its precision with the closest beat of the beatmap.
+func Judge(beatMap []Beat, elapsed float64) int {
+	return Score(ClosestBeat(beatMap, elapsed) - elapsed)
+}
+
+// Offset returns the timing offset (in seconds) of a hit made at the elapsed time of the music
+// with the closest beat of the beatmap. It is positive when the hit is late and negative when early.
+func Offset(beatMap []Beat, elapsed float64) float64 {
+	return elapsed - ClosestBeat(beatMap, elapsed)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package rhythm
+
+import (
+	"math"
+	"testing"
+)
+
+var judgeBeatMap = []Beat{
+	{Time: 1.0, BeatNum: 1},
+	{Time: 2.0, BeatNum: 2},
+	{Time: 3.0, BeatNum: 3},
+}
+
+// TestClosestBeat tests the ClosestBeat function.
+// Checks if the closest beat is found before and after the elapsed time.
+func TestClosestBeat(t *testing.T) {
+	if beat := ClosestBeat(judgeBeatMap, 1.2); beat != 1.0 {
+		t.Errorf("Expected closest beat 1.0, got %v", beat)
+	}
+	if beat := ClosestBeat(judgeBeatMap, 2.9); beat != 3.0 {
+		t.Errorf("Expected closest beat 3.0, got %v", beat)
+	}
+}
+
+// TestScore tests the Score function.
+// Checks if each precision window gives the expected score, early or late.
+func TestScore(t *testing.T) {
+	tests := []struct {
+		difference float64
+		expected   int
+	}{
+		{0, PerfectScore},
+		{-0.05, PerfectScore},
+		{0.2, GoodScore},
+		{-0.3, OkScore},
+		{0.5, MissedScore},
+	}
+	for _, test := range tests {
+		if score := Score(test.difference); score != test.expected {
+			t.Errorf("Expected score %d for difference %v, got %d", test.expected, test.difference, score)
+		}
+	}
+}
+
+// TestJudge tests the Judge function.
+// Checks if a hit is judged against the closest beat of the beatmap.
+func TestJudge(t *testing.T) {
+	if score := Judge(judgeBeatMap, 2.05); score != PerfectScore {
+		t.Errorf("Expected score %d, got %d", PerfectScore, score)
+	}
+	if score := Judge(judgeBeatMap, 2.5); score != MissedScore {
+		t.Errorf("Expected score %d, got %d", MissedScore, score)
+	}
+}
+
+// TestLabel tests the Label function.
+// Checks if each score has its judgement name.
+func TestLabel(t *testing.T) {
+	labels := map[int]string{
+		PerfectScore: "Perfect",
+		GoodScore:    "Good",
+		OkScore:      "OK",
+		MissedScore:  "Miss",
+	}
+	for score, expected := range labels {
+		if label := Label(score); label != expected {
+			t.Errorf("Expected %q for score %d, got %q", expected, score, label)
+		}
+	}
+}
+
+// TestOffset tests the Offset function.
+// Checks if the late hits have a positive offset and the early hits a negative offset.
+func TestOffset(t *testing.T) {
+	if offset := Offset(judgeBeatMap, 1.25); math.Abs(offset-0.25) > 1e-9 {
+		t.Errorf("Expected offset 0.25, got %v", offset)
+	}
+	if offset := Offset(judgeBeatMap, 1.9); math.Abs(offset+0.1) > 1e-9 {
+		t.Errorf("Expected offset -0.1, got %v", offset)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrGameOver      = errors.New("game over")
+	ErrOutOfBoard    = errors.New("the position is out of the board")
+	ErrCellTaken     = errors.New("the cell is not empty")
+	ErrInvalidSymbol = errors.New("the symbol cannot be placed by the player")
+	ErrInvalidPlayer = errors.New("invalid player")
+)
+
+// A Move struct contains the cell of a move, indexed by [x][y], and the symbol placed.
+type Move struct {
+	X, Y   int
+	Symbol Symbol
+}
+
+// A Game struct contains the state of a 3x3 game played with a rule variant.
+type Game struct {
+	Board   Board   // The board
+	Turn    Symbol  // The player to move
+	Variant Variant // The rule variant
+	Rounds  int     // The number of moves played
+	History []Move  // The moves played, in order
+
+	queues map[Symbol]*MoveQueue // The symbols of each player still on the board, oldest first
+	winner Symbol
+	line   [][2]int
+}
+
+// NewGame creates a new game with the rule variant, started by the given player.
+func NewGame(first Symbol, variant Variant) *Game {
+	variant = variant.Normalize()
+	queues := map[Symbol]*MoveQueue{}
+	for _, player := range []Symbol{X, O} {
+		// The capacity of a normalized variant is valid
+		queues[player], _ = NewMoveQueue(variant.MaxSymbols)
+	}
+	return &Game{
+		Turn:    first,
+		Variant: variant,
+		queues:  queues,
+	}
+}
+
+// Clone returns an independent copy of the game.
+func (g *Game) Clone() *Game {
+	clone := *g
+	// The moves of the clone are appended to a new array
+	clone.History = g.History[:len(g.History):len(g.History)]
+	clone.queues = make(map[Symbol]*MoveQueue, len(g.queues))
+	for player, queue := range g.queues {
+		clone.queues[player] = queue.Clone()
+	}
+	return &clone
+}
+
+// Legal returns an error if the move cannot be played by the current player.
+func (g *Game) Legal(m Move) error {
+	if g.Over() {
+		return ErrGameOver
+	}
+	if !g.Turn.Valid() {
+		return ErrInvalidPlayer
+	}
+	if !InBounds(m.X, m.Y) {
+		return ErrOutOfBoard
+	}
+	if g.Board[m.X][m.Y] != None {
+		return ErrCellTaken
+	}
+	for _, symbol := range g.Variant.Symbols(g.Turn) {
+		if symbol == m.Symbol {
+			return nil
+		}
+	}
+	return ErrInvalidSymbol
+}
+
+// Moves returns the legal moves of the current player.
+func (g *Game) Moves() []Move {
+	if g.Over() {
+		return nil
+	}
+	var moves []Move
+	for x := 0; x < 3; x++ {
+		for y := 0; y < 3; y++ {
+			if g.Board[x][y] != None {
+				continue
+			}
+			for _, symbol := range g.Variant.Symbols(g.Turn) {
+				moves = append(moves, Move{x, y, symbol})
+			}
+		}
+	}
+	return moves
+}
+
+// Play plays the move for the current player and switches the player. With a symbol limit,
+// the oldest symbol of the player is removed first when the limit is reached, its position is returned.
+func (g *Game) Play(m Move) (removed [][2]int, err error) {
+	if err := g.Legal(m); err != nil {
+		return nil, err
+	}
+	player := g.Turn
+	if oldest, ok := g.queues[player].Push([2]int{m.X, m.Y}); ok {
+		g.Board[oldest[0]][oldest[1]] = None
+		removed = append(removed, oldest)
+	}
+	g.Board[m.X][m.Y] = m.Symbol
+	g.Turn = player.Opponent()
+	g.Rounds++
+	g.History = append(g.History, m)
+
+	if _, line := g.Board.Winner(); line != nil {
+		g.winner = g.Variant.Winner(player)
+		g.line = line
+	}
+	return removed, nil
+}
+
+// NextRemoval returns the position of the symbol of the player removed on its next move,
+// and false if the player has not reached the symbol limit.
+func (g *Game) NextRemoval(player Symbol) ([2]int, bool) {
+	queue, ok := g.queues[player]
+	if !ok || !queue.Full() {
+		return [2]int{}, false
+	}
+	next, err := queue.Peek()
+	return next, err == nil
+}
+
+// Queue returns the symbols of the player still on the board in the order they will be removed
+// with a symbol limit, the next one first.
+func (g *Game) Queue(player Symbol) ([][2]int, error) {
+	queue, ok := g.queues[player]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidPlayer, player)
+	}
+	return queue.Cells(), nil
+}
+
+// Winner returns the winner of the game and the aligned positions, or None and nil.
+// In the misère and Notakto variants, the winner is not the player who aligned the symbols.
+func (g *Game) Winner() (Symbol, [][2]int) {
+	return g.winner, g.line
+}
+
+// Over returns true if the game is won or if the board is full.
+func (g *Game) Over() bool {
+	return g.line != nil || g.Board.Full()
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestGame_Play tests the Play function of a classic game.
+// Checks if the players alternate, if a taken cell or the symbol of the opponent are refused
+// and if three aligned symbols win the game.
+func TestGame_Play(t *testing.T) {
+	g := NewGame(X, Variant{})
+	if _, err := g.Play(Move{1, 1, O}); !errors.Is(err, ErrInvalidSymbol) {
+		t.Errorf("Expected %v, got %v", ErrInvalidSymbol, err)
+	}
+	for _, m := range []Move{{0, 0, X}, {1, 0, O}, {0, 1, X}, {1, 1, O}} {
+		if _, err := g.Play(m); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if _, err := g.Play(Move{1, 1, X}); !errors.Is(err, ErrCellTaken) {
+		t.Errorf("Expected %v, got %v", ErrCellTaken, err)
+	}
+	g.Play(Move{0, 2, X})
+	if winner, line := g.Winner(); winner != X || len(line) != 3 || !g.Over() {
+		t.Errorf("Expected X to win, got %q %v", winner, line)
+	}
+	if _, err := g.Play(Move{2, 2, O}); !errors.Is(err, ErrGameOver) {
+		t.Errorf("Expected %v, got %v", ErrGameOver, err)
+	}
+	if len(g.History) != 5 || g.History[4] != (Move{0, 2, X}) {
+		t.Errorf("Expected the 5 moves played in the history, got %v", g.History)
+	}
+}
+
+// TestGame_Play_misere tests the Play function of the misère and Notakto variants.
+// Checks if the player aligning three symbols loses.
+func TestGame_Play_misere(t *testing.T) {
+	for _, variant := range []Variant{{Misere: true}, {Notakto: true}} {
+		g := NewGame(O, variant)
+		symbol := func(player Symbol) Symbol { return variant.Symbols(player)[0] }
+		for _, cell := range [][2]int{{0, 0}, {2, 1}, {1, 1}, {2, 0}, {2, 2}} {
+			if _, err := g.Play(Move{cell[0], cell[1], symbol(g.Turn)}); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		}
+		if winner, _ := g.Winner(); winner != X {
+			t.Errorf("Expected X to win in %s, got %q", variant, winner)
+		}
+	}
+}
+
+// TestGame_Play_wild tests the Play function of the Wild variant.
+// Checks if a player can place both symbols and wins by aligning the symbols of its opponent.
+func TestGame_Play_wild(t *testing.T) {
+	g := NewGame(X, Variant{Wild: true})
+	if moves := g.Moves(); len(moves) != 18 {
+		t.Errorf("Expected 18 moves, got %d", len(moves))
+	}
+	for _, m := range []Move{{0, 0, O}, {2, 2, X}, {0, 1, O}} {
+		g.Play(m)
+	}
+	g.Play(Move{0, 2, O})
+	if winner, _ := g.Winner(); winner != O {
+		t.Errorf("Expected O to win with the symbols of both players, got %q", winner)
+	}
+}
+
+// TestGame_Play_maxSymbols tests the Play function with a limit of three symbols per player.
+// Checks if the oldest symbol of the player is announced then removed when a fourth one is placed.
+func TestGame_Play_maxSymbols(t *testing.T) {
+	g := NewGame(X, Variant{MaxSymbols: 3})
+	for _, m := range []Move{{1, 1, X}, {0, 0, O}, {2, 2, X}, {0, 1, O}, {2, 0, X}} {
+		if _, err := g.Play(m); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if next, ok := g.NextRemoval(X); !ok || next != [2]int{1, 1} {
+		t.Errorf("Expected (1, 1) to be removed next, got %v %v", next, ok)
+	}
+	if _, ok := g.NextRemoval(O); ok {
+		t.Error("Expected no removal for O")
+	}
+	g.Play(Move{1, 2, O})
+	removed, err := g.Play(Move{1, 0, X})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(removed) != 1 || removed[0] != [2]int{1, 1} || g.Board[1][1] != None {
+		t.Errorf("Expected (1, 1) to be removed, got %v", removed)
+	}
+}
+
+// TestVariant_String tests the String and Normalize functions.
+// Checks the names of the variants and if Notakto replaces Wild.
+func TestVariant_String(t *testing.T) {
+	if name := (Variant{}).String(); name != "Classic" {
+		t.Errorf("Expected Classic, got %s", name)
+	}
+	v := Variant{Misere: true, Wild: true, Notakto: true, MaxSymbols: 9}.Normalize()
+	if name := v.String(); name != "Misère, Notakto, max 4" {
+		t.Errorf("Expected Misère, Notakto, max 4, got %s", name)
+	}
+}
+
+// TestGame_Clone tests the Clone function.
+// Checks if the moves played on the clone do not change the board and the history of the game.
+func TestGame_Clone(t *testing.T) {
+	g := NewGame(X, Variant{MaxSymbols: 3})
+	g.Play(Move{0, 0, X})
+	clone := g.Clone()
+	clone.Play(Move{1, 1, O})
+	g.Play(Move{2, 2, O})
+	if g.Board[1][1] != None || len(g.History) != 2 || g.History[1] != (Move{2, 2, O}) {
+		t.Errorf("Expected the game to be unchanged by its clone, got %v", g.History)
+	}
+	if clone.History[1] != (Move{1, 1, O}) {
+		t.Errorf("Expected the clone to keep its move, got %v", clone.History)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+// Index returns the index of the cell at the given coordinates on a board of the given size
+// with one coordinate per dimension. The first coordinate varies the fastest.
+func Index(size int, coordinates ...int) int {
+	index := 0
+	for i := len(coordinates) - 1; i >= 0; i-- {
+		index = index*size + coordinates[i]
+	}
+	return index
+}
+
+// Coordinates returns the coordinates of the cell at the index on a board of the given size
+// and number of dimensions.
+func Coordinates(size, dimensions, index int) []int {
+	coordinates := make([]int, dimensions)
+	for i := range coordinates {
+		coordinates[i] = index % size
+		index /= size
+	}
+	return coordinates
+}
+
+// Lines returns all the winning lines of a board of the given size and number of dimensions,
+// a line is made of size cells aligned along a row, a column or any diagonal. Each line contains
+// the indexes of its cells in order and is returned once.
+// A 3x3 board has 8 lines, a 3x3x3 board 49 and a 4x4x4 board 76.
+func Lines(size, dimensions int) [][]int {
+	var lines [][]int
+	directions := make([]int, dimensions)
+	// Each direction is a vector of -1, 0 or 1 per dimension, its first non-zero component
+	// is positive so that each line is found once
+	var walk func(dimension int, nonZero bool)
+	walk = func(dimension int, nonZero bool) {
+		if dimension == dimensions {
+			if nonZero {
+				lines = append(lines, linesAlong(size, directions)...)
+			}
+			return
+		}
+		for _, d := range []int{0, 1, -1} {
+			if d == -1 && !nonZero {
+				continue
+			}
+			directions[dimension] = d
+			walk(dimension+1, nonZero || d != 0)
+		}
+	}
+	walk(0, false)
+	return lines
+}
+
+// linesAlong returns the lines of the board along the direction. A line starts on the first
+// cell of each moving coordinate, and on any cell of the fixed coordinates.
+func linesAlong(size int, directions []int) [][]int {
+	var lines [][]int
+	start := make([]int, len(directions))
+	var walk func(dimension int)
+	walk = func(dimension int) {
+		if dimension < len(directions) {
+			switch directions[dimension] {
+			case 1:
+				start[dimension] = 0
+				walk(dimension + 1)
+			case -1:
+				start[dimension] = size - 1
+				walk(dimension + 1)
+			default:
+				for c := 0; c < size; c++ {
+					start[dimension] = c
+					walk(dimension + 1)
+				}
+			}
+			return
+		}
+		line := make([]int, size)
+		coordinates := make([]int, len(directions))
+		for step := range line {
+			for i := range coordinates {
+				coordinates[i] = start[i] + step*directions[i]
+			}
+			line[step] = Index(size, coordinates...)
+		}
+		lines = append(lines, line)
+	}
+	walk(0)
+	return lines
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidCapacity = errors.New("invalid queue capacity")
+	ErrQueueEmpty      = errors.New("the queue is empty")
+)
+
+// A MoveQueue struct contains the cells of the symbols of a player still on the board, in the
+// order they will disappear. When the queue is full, pushing a cell removes the oldest one.
+type MoveQueue struct {
+	capacity int
+	cells    [][2]int
+}
+
+// NewMoveQueue creates an empty queue holding up to capacity cells, 0 for no limit.
+func NewMoveQueue(capacity int) (*MoveQueue, error) {
+	if capacity < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidCapacity, capacity)
+	}
+	return &MoveQueue{capacity: capacity}, nil
+}
+
+// Capacity returns the maximum number of cells of the queue, 0 for no limit.
+func (q *MoveQueue) Capacity() int {
+	return q.capacity
+}
+
+// Len returns the number of cells in the queue.
+func (q *MoveQueue) Len() int {
+	return len(q.cells)
+}
+
+// Full returns true if the next push removes the oldest cell.
+func (q *MoveQueue) Full() bool {
+	return q.capacity > 0 && len(q.cells) >= q.capacity
+}
+
+// Push adds a cell at the end of the queue. When the queue is full, the oldest cell is removed
+// first and returned with true.
+func (q *MoveQueue) Push(cell [2]int) (removed [2]int, ok bool) {
+	if q.Full() {
+		removed, _ = q.Pop()
+		ok = true
+	}
+	q.cells = append(q.cells, cell)
+	return removed, ok
+}
+
+// Pop removes and returns the oldest cell of the queue.
+func (q *MoveQueue) Pop() ([2]int, error) {
+	if len(q.cells) == 0 {
+		return [2]int{}, ErrQueueEmpty
+	}
+	oldest := q.cells[0]
+	q.cells = q.cells[1:]
+	return oldest, nil
+}
+
+// Peek returns the oldest cell of the queue without removing it.
+func (q *MoveQueue) Peek() ([2]int, error) {
+	if len(q.cells) == 0 {
+		return [2]int{}, ErrQueueEmpty
+	}
+	return q.cells[0], nil
+}
+
+// Cells returns a copy of the cells of the queue, the next one to disappear first.
+func (q *MoveQueue) Cells() [][2]int {
+	return append([][2]int(nil), q.cells...)
+}
+
+// Clone returns an independent copy of the queue.
+func (q *MoveQueue) Clone() *MoveQueue {
+	return &MoveQueue{capacity: q.capacity, cells: q.Cells()}
+}
+
+// jsonQueue is the JSON representation of a queue.
+type jsonQueue struct {
+	Capacity int      `json:"capacity"` // The maximum number of cells, 0 for no limit
+	Cells    [][2]int `json:"cells"`    // The cells, the next one to disappear first
+}
+
+// MarshalJSON encodes the queue with its capacity and its cells.
+func (q *MoveQueue) MarshalJSON() ([]byte, error) {
+	cells := q.cells
+	if cells == nil {
+		cells = [][2]int{}
+	}
+	return json.Marshal(jsonQueue{Capacity: q.capacity, Cells: cells})
+}
+
+// UnmarshalJSON decodes a queue encoded by MarshalJSON. It returns an error if the capacity is
+// negative, if there are more cells than the capacity or if a cell is out of the board.
+func (q *MoveQueue) UnmarshalJSON(data []byte) error {
+	var decoded jsonQueue
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	if decoded.Capacity < 0 || (decoded.Capacity > 0 && len(decoded.Cells) > decoded.Capacity) {
+		return fmt.Errorf("%w: %d cells for a capacity of %d", ErrInvalidCapacity, len(decoded.Cells), decoded.Capacity)
+	}
+	for _, cell := range decoded.Cells {
+		if !InBounds(cell[0], cell[1]) {
+			return fmt.Errorf("%w: %v", ErrOutOfBoard, cell)
+		}
+	}
+	q.capacity = decoded.Capacity
+	q.cells = decoded.Cells
+	return nil
+}
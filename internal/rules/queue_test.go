@@ -0,0 +1,98 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// TestNewMoveQueue tests the NewMoveQueue function.
+// Checks if a negative capacity is refused.
+func TestNewMoveQueue(t *testing.T) {
+	if _, err := NewMoveQueue(-1); !errors.Is(err, ErrInvalidCapacity) {
+		t.Errorf("Expected %v, got %v", ErrInvalidCapacity, err)
+	}
+	q, err := NewMoveQueue(0)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for i := 0; i < 9; i++ {
+		if _, ok := q.Push([2]int{i % 3, i / 3}); ok {
+			t.Fatal("Expected no removal without limit")
+		}
+	}
+}
+
+// TestMoveQueue_Push tests the Push, Peek and Pop functions.
+// Checks if the oldest cell is removed when the queue is full and if an empty queue returns an error.
+func TestMoveQueue_Push(t *testing.T) {
+	q, _ := NewMoveQueue(2)
+	q.Push([2]int{0, 0})
+	q.Push([2]int{1, 1})
+	if !q.Full() {
+		t.Error("Expected the queue to be full")
+	}
+	removed, ok := q.Push([2]int{2, 2})
+	if !ok || removed != [2]int{0, 0} {
+		t.Errorf("Expected [0 0] to be removed, got %v %v", removed, ok)
+	}
+	if cells := q.Cells(); len(cells) != 2 || cells[0] != [2]int{1, 1} || cells[1] != [2]int{2, 2} {
+		t.Errorf("Expected [[1 1] [2 2]], got %v", cells)
+	}
+	if next, err := q.Peek(); err != nil || next != [2]int{1, 1} {
+		t.Errorf("Expected [1 1] next, got %v %v", next, err)
+	}
+	q.Pop()
+	q.Pop()
+	if _, err := q.Pop(); !errors.Is(err, ErrQueueEmpty) {
+		t.Errorf("Expected %v, got %v", ErrQueueEmpty, err)
+	}
+}
+
+// TestMoveQueue_JSON tests the MarshalJSON and UnmarshalJSON functions.
+// Checks if a queue is decoded as encoded and if an invalid queue is refused.
+func TestMoveQueue_JSON(t *testing.T) {
+	q, _ := NewMoveQueue(3)
+	q.Push([2]int{2, 1})
+	q.Push([2]int{0, 2})
+	data, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(data) != `{"capacity":3,"cells":[[2,1],[0,2]]}` {
+		t.Errorf("Unexpected encoding %s", data)
+	}
+	var decoded MoveQueue
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if decoded.Capacity() != 3 || decoded.Len() != 2 || decoded.Cells()[1] != [2]int{0, 2} {
+		t.Errorf("Expected the decoded queue to match, got %v", decoded.Cells())
+	}
+
+	if err := json.Unmarshal([]byte(`{"capacity":1,"cells":[[0,0],[1,1]]}`), &decoded); !errors.Is(err, ErrInvalidCapacity) {
+		t.Errorf("Expected %v, got %v", ErrInvalidCapacity, err)
+	}
+	if err := json.Unmarshal([]byte(`{"capacity":3,"cells":[[0,3]]}`), &decoded); !errors.Is(err, ErrOutOfBoard) {
+		t.Errorf("Expected %v, got %v", ErrOutOfBoard, err)
+	}
+}
+
+// TestGame_Queue tests the Queue function.
+// Checks if the symbols of a player are listed in order and if an invalid player returns an error.
+func TestGame_Queue(t *testing.T) {
+	g := NewGame(O, Variant{MaxSymbols: 3})
+	for _, m := range []Move{{0, 0, O}, {1, 1, X}, {2, 2, O}} {
+		g.Play(m)
+	}
+	queue, err := g.Queue(O)
+	if err != nil || len(queue) != 2 || queue[0] != [2]int{0, 0} {
+		t.Errorf("Expected [[0 0] [2 2]], got %v %v", queue, err)
+	}
+	if _, err := g.Queue(None); !errors.Is(err, ErrInvalidPlayer) {
+		t.Errorf("Expected %v, got %v", ErrInvalidPlayer, err)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rules contains the Tic-Tac-Toe board rules shared by the game client and the
+// headless servers, without any dependency on the game engine.
+package rules
+
+// A Symbol type represent the symbol of a player or an empty cell.
+type Symbol string
+
+const (
+	None Symbol = ""  // Empty cell or no player
+	X    Symbol = "X" // Player X
+	O    Symbol = "O" // Player O
+)
+
+// A Board type represent a 3x3 board, indexed by [x][y].
+type Board [3][3]Symbol
+
+// boardLines contains the indexes of the cells of the lines that win the game, see Index.
+var boardLines = Lines(3, 2)
+
+// Opponent returns the symbol of the other player, or None if the symbol is None.
+func (s Symbol) Opponent() Symbol {
+	switch s {
+	case X:
+		return O
+	case O:
+		return X
+	}
+	return None
+}
+
+// Valid returns true if the symbol is the symbol of a player.
+func (s Symbol) Valid() bool {
+	return s == X || s == O
+}
+
+// InBounds returns true if the position is on the board.
+func InBounds(x, y int) bool {
+	return x >= 0 && x < 3 && y >= 0 && y < 3
+}
+
+// Winner returns the symbol aligned three times on the board and the winning positions.
+// It returns None and nil if there is no winner.
+func (b *Board) Winner() (Symbol, [][2]int) {
+	for _, line := range boardLines {
+		first := b.At(line[0])
+		if first != None && first == b.At(line[1]) && first == b.At(line[2]) {
+			positions := make([][2]int, len(line))
+			for i, index := range line {
+				positions[i] = [2]int{index % 3, index / 3}
+			}
+			return first, positions
+		}
+	}
+	return None, nil
+}
+
+// At returns the symbol of the cell at the index given by Index(3, x, y).
+func (b *Board) At(index int) Symbol {
+	return b[index%3][index/3]
+}
+
+// Full returns true if there is no empty cell left on the board.
+func (b *Board) Full() bool {
+	for x := 0; x < 3; x++ {
+		for y := 0; y < 3; y++ {
+			if b[x][y] == None {
+				return false
+			}
+		}
+	}
+	return true
+}
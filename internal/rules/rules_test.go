@@ -0,0 +1,119 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"testing"
+)
+
+// TestOpponent tests the Opponent function.
+// Checks if the symbols are swapped and if None stays None.
+func TestOpponent(t *testing.T) {
+	if X.Opponent() != O || O.Opponent() != X {
+		t.Error("Expected X and O to be opponents")
+	}
+	if None.Opponent() != None {
+		t.Errorf("Expected no opponent for None, got %s", None.Opponent())
+	}
+}
+
+// TestBoard_Winner tests the Winner function.
+// Checks if rows, columns and diagonals are detected with their positions.
+func TestBoard_Winner(t *testing.T) {
+	b := Board{
+		{X, O, None},
+		{O, X, None},
+		{None, O, X},
+	}
+	winner, line := b.Winner()
+	if winner != X {
+		t.Fatalf("Expected X to win, got %s", winner)
+	}
+	if len(line) != 3 || line[0] != [2]int{0, 0} || line[2] != [2]int{2, 2} {
+		t.Errorf("Expected the main diagonal, got %v", line)
+	}
+
+	b = Board{
+		{O, X, None},
+		{O, X, None},
+		{O, None, None},
+	}
+	if winner, _ := b.Winner(); winner != O {
+		t.Errorf("Expected O to win, got %s", winner)
+	}
+
+	b = Board{}
+	if winner, line := b.Winner(); winner != None || line != nil {
+		t.Errorf("Expected no winner on an empty board, got %s", winner)
+	}
+}
+
+// TestBoard_Full tests the Full function.
+// Checks if the board is full only when all cells are played.
+func TestBoard_Full(t *testing.T) {
+	b := Board{
+		{X, O, X},
+		{X, O, O},
+		{O, X, X},
+	}
+	if !b.Full() {
+		t.Error("Expected the board to be full")
+	}
+	b[1][1] = None
+	if b.Full() {
+		t.Error("Expected the board not to be full")
+	}
+}
+
+// TestLines tests the Lines function.
+// Checks the number of lines of the 2D and 3D boards and if the lines of a 3x3 board are the winning lines.
+func TestLines(t *testing.T) {
+	tests := []struct {
+		size, dimensions, lines int
+	}{
+		{3, 2, 8},
+		{4, 2, 10},
+		{3, 3, 49},
+		{4, 3, 76},
+	}
+	for _, test := range tests {
+		if lines := Lines(test.size, test.dimensions); len(lines) != test.lines {
+			t.Errorf("Expected %d lines for %d^%d, got %d", test.lines, test.size, test.dimensions, len(lines))
+		}
+	}
+
+	found := map[[3]int]bool{}
+	for _, line := range Lines(3, 2) {
+		found[[3]int{line[0], line[1], line[2]}] = true
+		found[[3]int{line[2], line[1], line[0]}] = true
+	}
+	winLines := [8][3][2]int{
+		{{0, 0}, {0, 1}, {0, 2}}, {{1, 0}, {1, 1}, {1, 2}}, {{2, 0}, {2, 1}, {2, 2}},
+		{{0, 0}, {1, 0}, {2, 0}}, {{0, 1}, {1, 1}, {2, 1}}, {{0, 2}, {1, 2}, {2, 2}},
+		{{0, 0}, {1, 1}, {2, 2}}, {{0, 2}, {1, 1}, {2, 0}},
+	}
+	for _, line := range winLines {
+		var indexes [3]int
+		for i, p := range line {
+			indexes[i] = Index(3, p[0], p[1])
+		}
+		if !found[indexes] {
+			t.Errorf("Expected the winning line %v in the lines", line)
+		}
+	}
+}
+
+// TestCoordinates tests the Index and Coordinates functions.
+// Checks if the coordinates of an index give back the index.
+func TestCoordinates(t *testing.T) {
+	for index := 0; index < 64; index++ {
+		coordinates := Coordinates(4, 3, index)
+		if got := Index(4, coordinates...); got != index {
+			t.Errorf("Expected index %d for %v, got %d", index, coordinates, got)
+		}
+	}
+	if index := Index(3, 1, 2); index != 7 {
+		t.Errorf("Expected index 7, got %d", index)
+	}
+}
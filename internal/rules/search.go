@@ -0,0 +1,18 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+const (
+	FullSearchDepth  = 9 // The search depth solving a game without symbol limit
+	ShortSearchDepth = 6 // The search depth of the Wild variant and of the games with a symbol limit
+)
+
+// SearchDepth returns the search depth that keeps the search of the AI fast with the variant:
+// the Wild variant doubles the moves and a symbol limit makes the game endless.
+func (v Variant) SearchDepth() int {
+	if v.Wild || v.MaxSymbols > 0 {
+		return ShortSearchDepth
+	}
+	return FullSearchDepth
+}
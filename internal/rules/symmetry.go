@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+// A Symmetry type represents one of the rotations and reflections of the board. The symmetric
+// positions of a game have the same value.
+type Symmetry int
+
+const (
+	Identity      Symmetry = iota // Leaves the board unchanged
+	Rotate90                      // Rotates the board a quarter turn
+	Rotate180                     // Rotates the board a half turn
+	Rotate270                     // Rotates the board three quarter turns
+	MirrorX                       // Reflects the board across its vertical middle line
+	MirrorY                       // Reflects the board across its horizontal middle line
+	Transpose                     // Reflects the board across its main diagonal
+	AntiTranspose                 // Reflects the board across its other diagonal
+	SymmetryCount                 // The number of symmetries of the board
+)
+
+// Apply returns the cell the symmetry moves the cell (x, y) to.
+func (s Symmetry) Apply(x, y int) (int, int) {
+	switch s {
+	case Rotate90:
+		return 2 - y, x
+	case Rotate180:
+		return 2 - x, 2 - y
+	case Rotate270:
+		return y, 2 - x
+	case MirrorX:
+		return 2 - x, y
+	case MirrorY:
+		return x, 2 - y
+	case Transpose:
+		return y, x
+	case AntiTranspose:
+		return 2 - y, 2 - x
+	}
+	return x, y
+}
+
+// Inverse returns the symmetry moving the cells back.
+func (s Symmetry) Inverse() Symmetry {
+	switch s {
+	case Rotate90:
+		return Rotate270
+	case Rotate270:
+		return Rotate90
+	}
+	return s
+}
+
+// Transform returns the board with each symbol moved by the symmetry.
+func (b *Board) Transform(s Symmetry) Board {
+	var t Board
+	for x := 0; x < 3; x++ {
+		for y := 0; y < 3; y++ {
+			tx, ty := s.Apply(x, y)
+			t[tx][ty] = b[x][y]
+		}
+	}
+	return t
+}
+
+// Key returns the number of the board, its cells being the base 3 digits of the number: 0 for
+// an empty cell, 1 for X and 2 for O.
+func (b *Board) Key() uint32 {
+	var key uint32
+	for x := 0; x < 3; x++ {
+		for y := 0; y < 3; y++ {
+			key *= 3
+			switch b[x][y] {
+			case X:
+				key++
+			case O:
+				key += 2
+			}
+		}
+	}
+	return key
+}
+
+// Canonical returns the board with the smallest key among the symmetric boards, the same for
+// all of them, and the symmetry transforming the board into it.
+func (b *Board) Canonical() (Board, Symmetry) {
+	best, symmetry := *b, Identity
+	bestKey := b.Key()
+	for s := Identity + 1; s < SymmetryCount; s++ {
+		t := b.Transform(s)
+		if key := t.Key(); key < bestKey {
+			best, symmetry, bestKey = t, s, key
+		}
+	}
+	return best, symmetry
+}
+
+// Invariants returns the symmetries leaving the board unchanged, the identity first.
+func (b *Board) Invariants() []Symmetry {
+	var invariants []Symmetry
+	for s := Identity; s < SymmetryCount; s++ {
+		if b.Transform(s) == *b {
+			invariants = append(invariants, s)
+		}
+	}
+	return invariants
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import "testing"
+
+// TestSymmetry_Inverse tests the Inverse function.
+// Checks if each symmetry followed by its inverse leaves every cell unchanged.
+func TestSymmetry_Inverse(t *testing.T) {
+	for s := Identity; s < SymmetryCount; s++ {
+		for x := 0; x < 3; x++ {
+			for y := 0; y < 3; y++ {
+				if ix, iy := s.Inverse().Apply(s.Apply(x, y)); ix != x || iy != y {
+					t.Errorf("Expected (%d, %d) back with symmetry %d, got (%d, %d)", x, y, s, ix, iy)
+				}
+			}
+		}
+	}
+}
+
+// TestBoard_Canonical tests the Canonical function.
+// Checks if the symmetric boards share their canonical board and if the returned symmetry gives it.
+func TestBoard_Canonical(t *testing.T) {
+	b := Board{{X, None, O}, {None, X}}
+	canonical, _ := b.Canonical()
+	for s := Identity; s < SymmetryCount; s++ {
+		symmetric := b.Transform(s)
+		c, symmetry := symmetric.Canonical()
+		if c != canonical {
+			t.Errorf("Expected the canonical board %v for symmetry %d, got %v", canonical, s, c)
+		}
+		if symmetric.Transform(symmetry) != c {
+			t.Errorf("Expected symmetry %d to give the canonical board", symmetry)
+		}
+	}
+}
+
+// TestBoard_Invariants tests the Invariants function.
+// Checks if the empty board has all the symmetries and a corner only the identity and its diagonal.
+func TestBoard_Invariants(t *testing.T) {
+	if n := len((&Board{}).Invariants()); n != int(SymmetryCount) {
+		t.Errorf("Expected %d symmetries of the empty board, got %d", SymmetryCount, n)
+	}
+	corner := Board{{X}}
+	if invariants := corner.Invariants(); len(invariants) != 2 || invariants[0] != Identity || invariants[1] != Transpose {
+		t.Errorf("Expected the identity and the transpose, got %v", invariants)
+	}
+}
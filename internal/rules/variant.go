@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	MinSymbolLimit = 3 // The smallest limit of symbols per player, a line needs three symbols
+	MaxSymbolLimit = 4 // The largest limit of symbols per player that can leave empty cells
+)
+
+// A Variant struct contains the rule variants of a 3x3 game. The zero value is the classic game.
+type Variant struct {
+	Misere     bool `json:"misere"`     // Aligning three symbols loses the game
+	Wild       bool `json:"wild"`       // Each move chooses to place an X or an O
+	Notakto    bool `json:"notakto"`    // Both players place X and aligning three symbols loses
+	MaxSymbols int  `json:"maxSymbols"` // The symbols per player on the board, the oldest is removed, 0 for no limit
+}
+
+// Classic returns true if no variant is enabled.
+func (v Variant) Classic() bool {
+	return v == Variant{}
+}
+
+// Normalize returns the variant with a valid symbol limit. Notakto replaces the Wild variant
+// since only X is placed.
+func (v Variant) Normalize() Variant {
+	if v.MaxSymbols != 0 {
+		v.MaxSymbols = min(max(v.MaxSymbols, MinSymbolLimit), MaxSymbolLimit)
+	}
+	if v.Notakto {
+		v.Wild = false
+	}
+	return v
+}
+
+// Symbols returns the symbols the player can place.
+func (v Variant) Symbols(player Symbol) []Symbol {
+	switch {
+	case v.Notakto:
+		return []Symbol{X}
+	case v.Wild:
+		return []Symbol{X, O}
+	}
+	return []Symbol{player}
+}
+
+// Winner returns the winner of the game when the player aligns three symbols: the player,
+// or its opponent in the misère and Notakto variants.
+func (v Variant) Winner(player Symbol) Symbol {
+	if v.Misere || v.Notakto {
+		return player.Opponent()
+	}
+	return player
+}
+
+// String returns the names of the enabled variants, as "Misère, Wild, max 3".
+func (v Variant) String() string {
+	var names []string
+	if v.Misere {
+		names = append(names, "Misère")
+	}
+	if v.Wild {
+		names = append(names, "Wild")
+	}
+	if v.Notakto {
+		names = append(names, "Notakto")
+	}
+	if v.MaxSymbols > 0 {
+		names = append(names, fmt.Sprintf("max %d", v.MaxSymbols))
+	}
+	if len(names) == 0 {
+		return "Classic"
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package scores provides the local high-score table of GoRythm. The results are recorded
+// per song and per difficulty, with the best results of all players and the personal best
+// of each player, and saved in the persistent storage.
+package scores
+
+import (
+	"GoRythm/internal/storage"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	fileName   = "scores.json" // The name of the scores in the storage
+	MaxEntries = 10            // The number of results kept in a high-score table
+)
+
+// A Result struct contains the result of a player at the end of a game.
+type Result struct {
+	Player   string    `json:"player"`   // The name of the player
+	Score    int       `json:"score"`    // The final score
+	Accuracy float64   `json:"accuracy"` // The accuracy of the hits (in percent)
+	MaxCombo int       `json:"maxCombo"` // The longest combo of hits on the beat
+	Perfect  int       `json:"perfect"`  // The number of perfect hits
+	Good     int       `json:"good"`     // The number of good hits
+	Ok       int       `json:"ok"`       // The number of OK hits
+	Miss     int       `json:"miss"`     // The number of missed hits
+	Hints    int       `json:"hints"`    // The number of hints asked during the game
+	Date     time.Time `json:"date"`     // The end of the game
+}
+
+// Assisted returns true if the player asked for hints during the game.
+func (r Result) Assisted() bool {
+	return r.Hints > 0
+}
+
+// better returns true if the result a is ranked before the result b: higher score,
+// then higher accuracy, then the oldest.
+func better(a, b Result) bool {
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	if a.Accuracy != b.Accuracy {
+		return a.Accuracy > b.Accuracy
+	}
+	return a.Date.Before(b.Date)
+}
+
+// A Table struct contains the high scores by song and difficulty.
+type Table struct {
+	Top   map[string]map[string][]Result          `json:"top"`   // The best results by song and difficulty
+	Bests map[string]map[string]map[string]Result `json:"bests"` // The personal bests by song, difficulty and player
+}
+
+// NewTable creates an empty table.
+func NewTable() *Table {
+	return &Table{
+		Top:   map[string]map[string][]Result{},
+		Bests: map[string]map[string]map[string]Result{},
+	}
+}
+
+// Load returns the saved table, or an empty table if none was saved.
+func Load() (*Table, error) {
+	t := NewTable()
+	data, err := storage.Load(fileName)
+	if errors.Is(err, storage.ErrNotFound) {
+		return t, nil
+	}
+	if err != nil {
+		return t, err
+	}
+	if err := json.Unmarshal(data, t); err != nil {
+		return NewTable(), fmt.Errorf("failed to parse %s: %w", fileName, err)
+	}
+	if t.Top == nil {
+		t.Top = map[string]map[string][]Result{}
+	}
+	if t.Bests == nil {
+		t.Bests = map[string]map[string]map[string]Result{}
+	}
+	return t, nil
+}
+
+// Save saves the table in the persistent storage.
+func (t *Table) Save() error {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	return storage.Save(fileName, data)
+}
+
+// Add records a result of the song at the given difficulty. It returns the rank of the
+// result in the high scores (from 0), or -1 if it is not among the MaxEntries best,
+// and whether it is a new personal best of the player.
+func (t *Table) Add(song, difficulty string, r Result) (rank int, personalBest bool) {
+	if t.Top[song] == nil {
+		t.Top[song] = map[string][]Result{}
+	}
+	results := append(t.Top[song][difficulty], r)
+	sort.SliceStable(results, func(i, j int) bool { return better(results[i], results[j]) })
+	rank = -1
+	for i := range results {
+		if results[i] == r {
+			rank = i
+			break
+		}
+	}
+	if len(results) > MaxEntries {
+		results = results[:MaxEntries]
+	}
+	if rank >= MaxEntries {
+		rank = -1
+	}
+	t.Top[song][difficulty] = results
+
+	if t.Bests[song] == nil {
+		t.Bests[song] = map[string]map[string]Result{}
+	}
+	if t.Bests[song][difficulty] == nil {
+		t.Bests[song][difficulty] = map[string]Result{}
+	}
+	best, ok := t.Bests[song][difficulty][r.Player]
+	if !ok || better(r, best) {
+		t.Bests[song][difficulty][r.Player] = r
+		personalBest = true
+	}
+	return rank, personalBest
+}
+
+// HighScores returns the best results of the song at the given difficulty, the best first.
+func (t *Table) HighScores(song, difficulty string) []Result {
+	return t.Top[song][difficulty]
+}
+
+// PersonalBest returns the best result of the player for the song at the given difficulty.
+func (t *Table) PersonalBest(song, difficulty, player string) (Result, bool) {
+	best, ok := t.Bests[song][difficulty][player]
+	return best, ok
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package scores
+
+import (
+	"GoRythm/internal/rhythm"
+	"GoRythm/internal/storage"
+	"testing"
+	"time"
+)
+
+var date = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// TestTally_Add tests the Add function of the Tally.
+// Checks if the judgements, the combo and the accuracy are counted.
+func TestTally_Add(t *testing.T) {
+	var tally Tally
+	for _, score := range []int{rhythm.PerfectScore, rhythm.GoodScore, rhythm.MissedScore, rhythm.OkScore, rhythm.PerfectScore, rhythm.PerfectScore} {
+		tally.Add(score)
+	}
+	if tally.Perfect != 3 || tally.Good != 1 || tally.Ok != 1 || tally.Miss != 1 {
+		t.Errorf("Expected 3 perfect, 1 good, 1 OK and 1 miss, got %v", tally)
+	}
+	if tally.MaxCombo != 3 || tally.Combo != 3 {
+		t.Errorf("Expected a combo of 3, got %d and %d", tally.MaxCombo, tally.Combo)
+	}
+	expected := 100 * float64(3*rhythm.PerfectScore+rhythm.GoodScore+rhythm.OkScore) / float64(6*rhythm.PerfectScore)
+	if tally.Accuracy() != expected {
+		t.Errorf("Expected accuracy %v, got %v", expected, tally.Accuracy())
+	}
+	tally.AddTimed(rhythm.GoodScore, 0.2)
+	tally.AddTimed(rhythm.GoodScore, -0.1)
+	if offset := tally.AverageOffset(); offset != 0.1/8 {
+		t.Errorf("Expected average offset %v, got %v", 0.1/8, offset)
+	}
+	if (Tally{}).Accuracy() != 0 {
+		t.Error("Expected accuracy 0 without hits")
+	}
+}
+
+// TestTally_Result tests the Result function of the Tally.
+// Checks if the hints asked mark the result as assisted.
+func TestTally_Result(t *testing.T) {
+	tally := Tally{Perfect: 2, Points: 2 * rhythm.PerfectScore}
+	if r := tally.Result("alice", 10, date); r.Assisted() || r.Perfect != 2 || r.Score != 10 {
+		t.Errorf("Expected an unassisted result with 2 perfect hits, got %+v", r)
+	}
+	tally.Hints = 2
+	if r := tally.Result("alice", 10, date); !r.Assisted() || r.Hints != 2 {
+		t.Errorf("Expected a result assisted by 2 hints, got %+v", r)
+	}
+}
+
+// TestTable_Add tests the Add function of the Table.
+// Checks if the results are ranked by score and if the personal bests are kept.
+func TestTable_Add(t *testing.T) {
+	table := NewTable()
+	if rank, best := table.Add("song", "hard", Result{Player: "alice", Score: 100, Date: date}); rank != 0 || !best {
+		t.Errorf("Expected rank 0 and a personal best, got %d and %v", rank, best)
+	}
+	if rank, best := table.Add("song", "hard", Result{Player: "bob", Score: 300, Date: date}); rank != 0 || !best {
+		t.Errorf("Expected rank 0 and a personal best, got %d and %v", rank, best)
+	}
+	if rank, best := table.Add("song", "hard", Result{Player: "alice", Score: 50, Date: date}); rank != 2 || best {
+		t.Errorf("Expected rank 2 and no personal best, got %d and %v", rank, best)
+	}
+
+	scores := table.HighScores("song", "hard")
+	if len(scores) != 3 || scores[0].Player != "bob" || scores[1].Score != 100 {
+		t.Errorf("Expected bob then alice with 100, got %v", scores)
+	}
+	if len(table.HighScores("song", "easy")) != 0 {
+		t.Error("Expected no high scores for another difficulty")
+	}
+	if best, ok := table.PersonalBest("song", "hard", "alice"); !ok || best.Score != 100 {
+		t.Errorf("Expected the personal best of alice to be 100, got %v", best)
+	}
+}
+
+// TestTable_Add_full tests the Add function with a full high-score table.
+// Checks if only the MaxEntries best results are kept.
+func TestTable_Add_full(t *testing.T) {
+	table := NewTable()
+	for i := 0; i < MaxEntries; i++ {
+		table.Add("song", "easy", Result{Player: "alice", Score: 100 + i, Date: date})
+	}
+	if rank, _ := table.Add("song", "easy", Result{Player: "bob", Score: 1, Date: date}); rank != -1 {
+		t.Errorf("Expected the result not to be ranked, got %d", rank)
+	}
+	if rank, _ := table.Add("song", "easy", Result{Player: "bob", Score: 1000, Date: date}); rank != 0 {
+		t.Errorf("Expected rank 0, got %d", rank)
+	}
+	if len(table.HighScores("song", "easy")) != MaxEntries {
+		t.Errorf("Expected %d results, got %d", MaxEntries, len(table.HighScores("song", "easy")))
+	}
+}
+
+// TestTable_Save tests the Save and Load functions in a temporary directory.
+// Checks if the high scores and personal bests are loaded back.
+func TestTable_Save(t *testing.T) {
+	storage.SetDir(t.TempDir())
+	defer storage.SetDir("")
+
+	table, err := Load()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	table.Add("song", "gorythm", Result{Player: "alice", Score: 900, Accuracy: 87.5, MaxCombo: 4, Perfect: 3, Date: date})
+	if err := table.Save(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	scores := loaded.HighScores("song", "gorythm")
+	if len(scores) != 1 || scores[0] != table.HighScores("song", "gorythm")[0] {
+		t.Errorf("Expected the saved result, got %v", scores)
+	}
+	if _, ok := loaded.PersonalBest("song", "gorythm", "alice"); !ok {
+		t.Error("Expected the personal best of alice to be loaded")
+	}
+}
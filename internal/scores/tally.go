@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package scores
+
+import (
+	"GoRythm/internal/rhythm"
+	"time"
+)
+
+// A Tally struct counts the judgements of the hits of a player during a game.
+type Tally struct {
+	Perfect  int // The number of perfect hits
+	Good     int // The number of good hits
+	Ok       int // The number of OK hits
+	Miss     int // The number of missed hits
+	Combo    int // The number of hits on the beat in a row
+	MaxCombo int // The longest combo of the game
+	Points   int // The sum of the scores of the hits
+	Hints    int // The number of hints asked
+
+	OffsetSum float64 // The sum of the timing offsets of the hits (in seconds)
+}
+
+// Add counts a hit judged with the given score (see rhythm.Score).
+// A miss breaks the combo.
+func (t *Tally) Add(score int) {
+	switch score {
+	case rhythm.PerfectScore:
+		t.Perfect++
+	case rhythm.GoodScore:
+		t.Good++
+	case rhythm.OkScore:
+		t.Ok++
+	default:
+		t.Miss++
+		t.Combo = 0
+		return
+	}
+	t.Points += score
+	t.Combo++
+	t.MaxCombo = max(t.MaxCombo, t.Combo)
+}
+
+// AddTimed counts a hit judged with the given score and its timing offset (see rhythm.Offset).
+func (t *Tally) AddTimed(score int, offset float64) {
+	t.Add(score)
+	t.OffsetSum += offset
+}
+
+// AverageOffset returns the average timing offset of the hits (in seconds), or 0 without hits.
+func (t Tally) AverageOffset() float64 {
+	if t.Hits() == 0 {
+		return 0
+	}
+	return t.OffsetSum / float64(t.Hits())
+}
+
+// Hits returns the number of judged hits.
+func (t Tally) Hits() int {
+	return t.Perfect + t.Good + t.Ok + t.Miss
+}
+
+// Accuracy returns the percentage of the score obtained over the score of perfect hits,
+// or 0 without hits.
+func (t Tally) Accuracy() float64 {
+	if t.Hits() == 0 {
+		return 0
+	}
+	return 100 * float64(t.Points) / float64(t.Hits()*rhythm.PerfectScore)
+}
+
+// Result returns the result of a player with the judgements of the tally.
+func (t Tally) Result(player string, score int, date time.Time) Result {
+	return Result{
+		Player:   player,
+		Score:    score,
+		Accuracy: t.Accuracy(),
+		MaxCombo: t.MaxCombo,
+		Perfect:  t.Perfect,
+		Good:     t.Good,
+		Ok:       t.Ok,
+		Miss:     t.Miss,
+		Hints:    t.Hints,
+		Date:     date,
+	}
+}
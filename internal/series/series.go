@@ -0,0 +1,152 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package series provides the series of games between two players: best of N games, or first
+// to N points. The starting player alternates between the games and the points of the games
+// are accumulated.
+package series
+
+import (
+	"GoRythm/internal/rules"
+	"fmt"
+)
+
+// A Format type represent the rule deciding the end of a series.
+type Format int
+
+const (
+	Single  Format = iota // A single game
+	BestOf                // The first player to win the majority of Target games
+	FirstTo               // The first player to reach Target points, a win is 1 point and a draw half a point
+)
+
+// A Config struct contains the format of a series.
+type Config struct {
+	Format Format
+	Target int // The number of games (BestOf) or points (FirstTo)
+}
+
+// The formats that can be chosen in the menu
+var Presets = []Config{
+	{Format: Single, Target: 1},
+	{Format: BestOf, Target: 3},
+	{Format: BestOf, Target: 5},
+	{Format: BestOf, Target: 7},
+	{Format: FirstTo, Target: 3},
+	{Format: FirstTo, Target: 5},
+}
+
+// String returns the name of the format of the series.
+func (c Config) String() string {
+	switch c.Format {
+	case BestOf:
+		return fmt.Sprintf("Best of %d", c.Target)
+	case FirstTo:
+		return fmt.Sprintf("First to %d", c.Target)
+	}
+	return "Single game"
+}
+
+// A Result struct contains the result of a game of a series.
+type Result struct {
+	Starter rules.Symbol // The player who started the game
+	Winner  rules.Symbol // The winner of the game, None for a draw
+	PointsX int          // The points of X in the game
+	PointsO int          // The points of O in the game
+}
+
+// A Series struct contains the results of the games of a series.
+type Series struct {
+	Config
+	Results []Result
+	first   rules.Symbol
+}
+
+// New creates a new series with the given format, the first game is started by the given player.
+func New(config Config, first rules.Symbol) *Series {
+	return &Series{Config: config, first: first}
+}
+
+// Starter returns the player starting the next game, alternating from the first player.
+func (s *Series) Starter() rules.Symbol {
+	if len(s.Results)%2 == 0 {
+		return s.first
+	}
+	return s.first.Opponent()
+}
+
+// Game returns the number of the next game (from 1).
+func (s *Series) Game() int {
+	return len(s.Results) + 1
+}
+
+// Add records the result of the game started by Starter.
+func (s *Series) Add(winner rules.Symbol, pointsX, pointsO int) {
+	s.Results = append(s.Results, Result{Starter: s.Starter(), Winner: winner, PointsX: pointsX, PointsO: pointsO})
+}
+
+// Wins returns the number of games won by the player.
+func (s *Series) Wins(player rules.Symbol) int {
+	wins := 0
+	for _, r := range s.Results {
+		if r.Winner == player {
+			wins++
+		}
+	}
+	return wins
+}
+
+// Draws returns the number of drawn games.
+func (s *Series) Draws() int {
+	return s.Wins(rules.None)
+}
+
+// Points returns the series points of the player: 1 per win and 0.5 per draw.
+func (s *Series) Points(player rules.Symbol) float64 {
+	return float64(s.Wins(player)) + float64(s.Draws())/2
+}
+
+// Total returns the cumulative points of the player in all the games.
+func (s *Series) Total(player rules.Symbol) int {
+	total := 0
+	for _, r := range s.Results {
+		if player == rules.X {
+			total += r.PointsX
+		} else {
+			total += r.PointsO
+		}
+	}
+	return total
+}
+
+// Over returns whether the series is over. A best of N is over when a player won the majority
+// of the games or when the N games were played, a first to N when a player reached N points.
+func (s *Series) Over() bool {
+	switch s.Format {
+	case BestOf:
+		majority := s.Target/2 + 1
+		return s.Wins(rules.X) >= majority || s.Wins(rules.O) >= majority || len(s.Results) >= s.Target
+	case FirstTo:
+		return s.Points(rules.X) >= float64(s.Target) || s.Points(rules.O) >= float64(s.Target)
+	}
+	return len(s.Results) >= 1
+}
+
+// Winner returns the winner of a finished series: the player with the most series points, then
+// with the most cumulative points. It returns None for a draw or a series not over.
+func (s *Series) Winner() rules.Symbol {
+	if !s.Over() {
+		return rules.None
+	}
+	x, o := s.Points(rules.X), s.Points(rules.O)
+	if x == o {
+		x, o = float64(s.Total(rules.X)), float64(s.Total(rules.O))
+	}
+	switch {
+	case x > o:
+		return rules.X
+	case o > x:
+		return rules.O
+	}
+	return rules.None
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package series
+
+import (
+	"GoRythm/internal/rules"
+	"testing"
+)
+
+// TestSeries_Starter tests the Starter function.
+// Checks if the starting player alternates between the games.
+func TestSeries_Starter(t *testing.T) {
+	s := New(Config{Format: BestOf, Target: 3}, rules.O)
+	expected := []rules.Symbol{rules.O, rules.X, rules.O}
+	for i, starter := range expected {
+		if s.Starter() != starter || s.Game() != i+1 {
+			t.Errorf("Expected %s to start game %d, got %s for game %d", starter, i+1, s.Starter(), s.Game())
+		}
+		s.Add(rules.None, 0, 0)
+	}
+	if s.Results[1].Starter != rules.X {
+		t.Errorf("Expected the starter to be recorded, got %s", s.Results[1].Starter)
+	}
+}
+
+// TestSeries_BestOf tests a best of 3 series.
+// Checks if the series ends when a player won 2 games and if the cumulative points are kept.
+func TestSeries_BestOf(t *testing.T) {
+	s := New(Config{Format: BestOf, Target: 3}, rules.X)
+	s.Add(rules.X, 500, 200)
+	if s.Over() {
+		t.Fatal("Expected the series to continue after 1 game")
+	}
+	s.Add(rules.O, 100, 400)
+	s.Add(rules.X, 300, 0)
+	if !s.Over() || s.Winner() != rules.X {
+		t.Errorf("Expected X to win the series, got over=%v winner=%q", s.Over(), s.Winner())
+	}
+	if s.Total(rules.X) != 900 || s.Total(rules.O) != 600 {
+		t.Errorf("Expected totals 900 and 600, got %d and %d", s.Total(rules.X), s.Total(rules.O))
+	}
+}
+
+// TestSeries_BestOf_draws tests a best of 3 series with draws.
+// Checks if the series ends after 3 games and is won on cumulative points.
+func TestSeries_BestOf_draws(t *testing.T) {
+	s := New(Config{Format: BestOf, Target: 3}, rules.X)
+	s.Add(rules.X, 1, 0)
+	s.Add(rules.O, 0, 1)
+	s.Add(rules.None, 0, 300)
+	if !s.Over() || s.Winner() != rules.O {
+		t.Errorf("Expected O to win on points, got over=%v winner=%q", s.Over(), s.Winner())
+	}
+}
+
+// TestSeries_FirstTo tests a first to 2 series.
+// Checks if a draw counts half a point.
+func TestSeries_FirstTo(t *testing.T) {
+	s := New(Config{Format: FirstTo, Target: 2}, rules.X)
+	s.Add(rules.O, 0, 1)
+	s.Add(rules.None, 0, 0)
+	if s.Over() || s.Points(rules.O) != 1.5 {
+		t.Fatalf("Expected O to have 1.5 points and the series to continue, got %v", s.Points(rules.O))
+	}
+	s.Add(rules.None, 0, 0)
+	if !s.Over() || s.Winner() != rules.O {
+		t.Errorf("Expected O to win with 2 points, got over=%v winner=%q", s.Over(), s.Winner())
+	}
+}
+
+// TestConfig_String tests the String function of the Config.
+// Checks the names of the formats.
+func TestConfig_String(t *testing.T) {
+	if name := (Config{Format: BestOf, Target: 5}).String(); name != "Best of 5" {
+		t.Errorf("Expected Best of 5, got %s", name)
+	}
+	if name := Presets[0].String(); name != "Single game" {
+		t.Errorf("Expected Single game, got %s", name)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package settings provides the user settings of GoRythm (volume, log level, window size and
+// game options) and their loading and saving in the persistent storage.
+package settings
+
+import (
+	"GoRythm/internal/log"
+	"GoRythm/internal/rules"
+	"GoRythm/internal/storage"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	fileName = "settings.json" // The name of the settings in the storage
+
+	DefaultVolume       = 0.05     // The default volume of the music
+	DefaultWindowScale  = 1.0      // The default window size, relative to the screen size
+	DefaultCountdown    = 3        // The default countdown before a game (in seconds)
+	DefaultPlayerName   = "Player" // The default name of the player
+	MinWindowScale      = 0.5      // The minimum window scale
+	MaxWindowScale      = 3.0      // The maximum window scale
+	MinCountdown        = 1        // The minimum countdown (in seconds)
+	MaxCountdown        = 10       // The maximum countdown (in seconds)
+	maxPlayerNameLength = 20       // The maximum length of the player name
+)
+
+// A Settings struct contains the user settings.
+type Settings struct {
+	Volume        float64       `json:"volume"`        // The volume of the music, between 0 and 1
+	LogLevel      string        `json:"logLevel"`      // The name of the log level
+	WindowScale   float64       `json:"windowScale"`   // The window size, relative to the screen size
+	Countdown     int           `json:"countdown"`     // The countdown before a game (in seconds)
+	PlayerName    string        `json:"playerName"`    // The name of the player in the online matches
+	ServerAddress string        `json:"serverAddress"` // The address of the server for the Online mode
+	Rules         rules.Variant `json:"rules"`         // The rule variants of the local 3x3 games
+}
+
+// Default returns the default settings.
+func Default() Settings {
+	return Settings{
+		Volume:      DefaultVolume,
+		LogLevel:    log.LevelName(log.CURRENT),
+		WindowScale: DefaultWindowScale,
+		Countdown:   DefaultCountdown,
+		PlayerName:  DefaultPlayerName,
+	}
+}
+
+// Load returns the saved settings, or the default settings if none were saved.
+// The invalid values are replaced by their default, the default settings are returned
+// with an error if the file can't be read.
+func Load() (Settings, error) {
+	s := Default()
+	data, err := storage.Load(fileName)
+	if errors.Is(err, storage.ErrNotFound) {
+		return s, nil
+	}
+	if err != nil {
+		return s, err
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return Default(), fmt.Errorf("failed to parse %s: %w", fileName, err)
+	}
+	return s.Normalize(), nil
+}
+
+// Save saves the settings in the persistent storage.
+func (s Settings) Save() error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return storage.Save(fileName, data)
+}
+
+// Normalize returns the settings with the values out of range clamped and the invalid
+// values replaced by their default.
+func (s Settings) Normalize() Settings {
+	s.Volume = min(max(s.Volume, 0), 1)
+	if _, err := log.ParseLevel(s.LogLevel); err != nil {
+		s.LogLevel = log.LevelName(log.CURRENT)
+	}
+	if s.WindowScale == 0 {
+		s.WindowScale = DefaultWindowScale
+	}
+	s.WindowScale = min(max(s.WindowScale, MinWindowScale), MaxWindowScale)
+	if s.Countdown == 0 {
+		s.Countdown = DefaultCountdown
+	}
+	s.Countdown = min(max(s.Countdown, MinCountdown), MaxCountdown)
+	if s.PlayerName == "" {
+		s.PlayerName = DefaultPlayerName
+	}
+	if runes := []rune(s.PlayerName); len(runes) > maxPlayerNameLength {
+		s.PlayerName = string(runes[:maxPlayerNameLength])
+	}
+	s.Rules = s.Rules.Normalize()
+	return s
+}
+
+// Level returns the log level of the settings.
+func (s Settings) Level() int {
+	level, err := log.ParseLevel(s.LogLevel)
+	if err != nil {
+		return log.CURRENT
+	}
+	return level
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package settings
+
+import (
+	"GoRythm/internal/log"
+	"GoRythm/internal/storage"
+	"testing"
+)
+
+// useTempStorage stores the settings in a temporary directory during the test.
+func useTempStorage(t *testing.T) {
+	t.Helper()
+	storage.SetDir(t.TempDir())
+	t.Cleanup(func() { storage.SetDir("") })
+}
+
+// TestLoad tests the Load function without saved settings.
+// Checks if the default settings are returned.
+func TestLoad(t *testing.T) {
+	useTempStorage(t)
+	s, err := Load()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if s != Default() {
+		t.Errorf("Expected the default settings, got %v", s)
+	}
+}
+
+// TestSettings_Save tests the Save function.
+// Checks if the saved settings are loaded back.
+func TestSettings_Save(t *testing.T) {
+	useTempStorage(t)
+	s := Default()
+	s.Volume = 0.5
+	s.LogLevel = "debug"
+	s.WindowScale = 1.5
+	s.Countdown = 5
+	s.PlayerName = "alice"
+	s.ServerAddress = "example.com:4242"
+	if err := s.Save(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if loaded != s {
+		t.Errorf("Expected %v, got %v", s, loaded)
+	}
+	if loaded.Level() != log.DEBUG {
+		t.Errorf("Expected the debug level, got %d", loaded.Level())
+	}
+}
+
+// TestLoad_invalid tests the Load function with an invalid settings file.
+// Checks if the default settings are returned with an error.
+func TestLoad_invalid(t *testing.T) {
+	useTempStorage(t)
+	if err := storage.Save(fileName, []byte("{not json")); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	s, err := Load()
+	if err == nil {
+		t.Error("Expected an error for an invalid file")
+	}
+	if s != Default() {
+		t.Errorf("Expected the default settings, got %v", s)
+	}
+}
+
+// TestSettings_Normalize tests the Normalize function.
+// Checks if the values out of range are clamped and the missing values replaced by their default.
+func TestSettings_Normalize(t *testing.T) {
+	s := Settings{Volume: 2, LogLevel: "verbose", WindowScale: 10, Countdown: -1}.Normalize()
+	if s.Volume != 1 || s.WindowScale != MaxWindowScale || s.Countdown != MinCountdown {
+		t.Errorf("Expected the values to be clamped, got %v", s)
+	}
+	if s.LogLevel != log.LevelName(log.CURRENT) || s.PlayerName != DefaultPlayerName {
+		t.Errorf("Expected the default log level and name, got %v", s)
+	}
+}
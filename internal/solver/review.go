@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package solver
+
+import (
+	"GoRythm/internal/rules"
+	"fmt"
+)
+
+// A Quality type represents how a move compares with the best move of its position.
+type Quality int
+
+const (
+	Best       Quality = iota // The move keeps the best value
+	Inaccuracy                // The move keeps the outcome but wins slower or loses faster
+	Mistake                   // The move throws away a win for a draw
+	Blunder                   // The move lets the opponent force a win
+)
+
+// String returns the name of the quality, as "blunder".
+func (q Quality) String() string {
+	switch q {
+	case Inaccuracy:
+		return "inaccuracy"
+	case Mistake:
+		return "mistake"
+	case Blunder:
+		return "blunder"
+	}
+	return "best"
+}
+
+// An Assessment struct contains a move of a game compared with the best move of its position.
+type Assessment struct {
+	Player  rules.Symbol // The player who played the move
+	Move    rules.Move   // The move played
+	Played  Value        // The value of the move played for the player
+	Best    MoveValue    // The best move of the position, the first one in the order of the cells
+	Quality Quality
+}
+
+// Comment returns what the move changed, as "let O force a win, (1, 1) was a draw".
+func (a Assessment) Comment() string {
+	best := fmt.Sprintf("(%d, %d)", a.Best.Move.X, a.Best.Move.Y)
+	switch a.Quality {
+	case Blunder:
+		return fmt.Sprintf("let %s force a win, %s was a %s", a.Player.Opponent(), best, a.Best.Value.Outcome)
+	case Mistake:
+		return fmt.Sprintf("threw away the win, %s won in %d", best, a.Best.Value.Distance)
+	case Inaccuracy:
+		if a.Played.Outcome == Win {
+			return fmt.Sprintf("wins slower, %s won in %d", best, a.Best.Value.Distance)
+		}
+		return fmt.Sprintf("loses faster, %s lost in %d", best, a.Best.Value.Distance)
+	}
+	return "best move"
+}
+
+// Review replays the moves of a game started by the player and compares each move with the
+// best move of its position. It returns an error at the first illegal move.
+func (s *Solver) Review(first rules.Symbol, moves []rules.Move) ([]Assessment, error) {
+	g := rules.NewGame(first, s.variant)
+	assessments := make([]Assessment, 0, len(moves))
+	for i, m := range moves {
+		if err := g.Legal(m); err != nil {
+			return nil, fmt.Errorf("move %d: %w", i+1, err)
+		}
+		values, err := s.Moves(g.Board, g.Turn)
+		if err != nil {
+			return nil, fmt.Errorf("move %d: %w", i+1, err)
+		}
+		a := Assessment{Player: g.Turn, Move: m, Best: values[0]}
+		for _, v := range values {
+			if v.Move == m {
+				a.Played = v.Value
+			}
+		}
+		a.Quality = quality(a.Played, a.Best.Value)
+		assessments = append(assessments, a)
+		g.Play(m)
+	}
+	return assessments, nil
+}
+
+// quality returns the quality of a move of the value when the best move has the best value.
+func quality(played, best Value) Quality {
+	switch {
+	case !best.Better(played):
+		return Best
+	case played.Outcome == best.Outcome:
+		return Inaccuracy
+	case played.Outcome == Loss:
+		return Blunder
+	}
+	return Mistake
+}
+
+// Count returns the number of moves of the player with the quality.
+func Count(assessments []Assessment, player rules.Symbol, q Quality) int {
+	count := 0
+	for _, a := range assessments {
+		if a.Player == player && a.Quality == q {
+			count++
+		}
+	}
+	return count
+}
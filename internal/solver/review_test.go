@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package solver
+
+import (
+	"GoRythm/internal/rules"
+	"errors"
+	"testing"
+)
+
+// TestSolver_Review tests the Review function.
+// Checks the quality of each move of a game lost by O and the comment of its blunder.
+func TestSolver_Review(t *testing.T) {
+	s, _ := New(rules.Variant{})
+	// O answers the center with an edge instead of a corner, then misses the block
+	moves := []rules.Move{{X: 1, Y: 1, Symbol: rules.X}, {X: 1, Y: 0, Symbol: rules.O}, {X: 0, Y: 0, Symbol: rules.X}, {X: 2, Y: 0, Symbol: rules.O}, {X: 2, Y: 2, Symbol: rules.X}}
+	assessments, err := s.Review(rules.X, moves)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	want := []Quality{Best, Blunder, Best, Inaccuracy, Best}
+	for i, a := range assessments {
+		if a.Quality != want[i] {
+			t.Errorf("Expected move %d to be a %s, got %s (%v instead of %v)", i+1, want[i], a.Quality, a.Played, a.Best)
+		}
+	}
+	if comment := assessments[1].Comment(); comment != "let X force a win, (0, 0) was a draw" {
+		t.Errorf("Expected the blunder to be explained, got %q", comment)
+	}
+	if Count(assessments, rules.O, Blunder) != 1 || Count(assessments, rules.X, Best) != 3 {
+		t.Errorf("Expected 1 blunder of O and 3 best moves of X, got %v", assessments)
+	}
+
+	illegal := []rules.Move{{X: 1, Y: 1, Symbol: rules.X}, {X: 1, Y: 1, Symbol: rules.O}}
+	if _, err := s.Review(rules.X, illegal); !errors.Is(err, rules.ErrCellTaken) {
+		t.Errorf("Expected %v, got %v", rules.ErrCellTaken, err)
+	}
+}
+
+// TestQuality tests the quality function.
+// Checks if a thrown win is a mistake and a slower win an inaccuracy.
+func TestQuality(t *testing.T) {
+	tests := []struct {
+		played, best Value
+		want         Quality
+	}{
+		{Value{Win, 3}, Value{Win, 3}, Best},
+		{Value{Win, 5}, Value{Win, 3}, Inaccuracy},
+		{Value{Draw, 6}, Value{Win, 3}, Mistake},
+		{Value{Loss, 4}, Value{Draw, 6}, Blunder},
+		{Value{Loss, 2}, Value{Loss, 4}, Inaccuracy},
+	}
+	for _, test := range tests {
+		if q := quality(test.played, test.best); q != test.want {
+			t.Errorf("Expected a %s for %v instead of %v, got %s", test.want, test.played, test.best, q)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package solver solves the 3x3 games exhaustively: every position reachable from the empty
+// board is labelled as a win, a draw or a loss for the player to move, with the number of moves
+// until the end of the game when both players play perfectly.
+package solver
+
+import (
+	"GoRythm/internal/rules"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrUnsupportedVariant = errors.New("the variant cannot be solved")
+	ErrUnreachable        = errors.New("the position cannot be reached")
+	ErrInvalidPosition    = errors.New("invalid position")
+)
+
+// An Outcome type represents the result of a game for the player to move with perfect play.
+type Outcome int
+
+const (
+	Loss Outcome = -1 // The player to move loses
+	Draw Outcome = 0  // The game is a draw
+	Win  Outcome = 1  // The player to move wins
+)
+
+// String returns the name of the outcome, as "win".
+func (o Outcome) String() string {
+	switch o {
+	case Win:
+		return "win"
+	case Loss:
+		return "loss"
+	}
+	return "draw"
+}
+
+// A Value struct contains the outcome of a position for the player to move and the number of
+// moves until the end of the game. The winner ends the game as fast as possible and the loser
+// as slowly as possible.
+type Value struct {
+	Outcome  Outcome
+	Distance int // The number of moves until the end of the game
+}
+
+// String returns the value as "win in 3", "loss in 2" or "draw".
+func (v Value) String() string {
+	if v.Outcome == Draw {
+		return v.Outcome.String()
+	}
+	return fmt.Sprintf("%s in %d", v.Outcome, v.Distance)
+}
+
+// Short returns the value as "W3", "L2" or "D".
+func (v Value) Short() string {
+	switch v.Outcome {
+	case Win:
+		return fmt.Sprintf("W%d", v.Distance)
+	case Loss:
+		return fmt.Sprintf("L%d", v.Distance)
+	}
+	return "D"
+}
+
+// Better returns true if the value is better than the other one for the player to move: a
+// win before a draw before a loss, a faster win or a slower loss.
+func (v Value) Better(w Value) bool {
+	return v.rank() > w.rank()
+}
+
+// rank returns a number ordering the values from the worst to the best.
+func (v Value) rank() int {
+	switch v.Outcome {
+	case Win:
+		return 100 - v.Distance
+	case Loss:
+		return -100 + v.Distance
+	}
+	return 0
+}
+
+// A MoveValue struct contains a legal move and the value of the position for the player
+// who plays it.
+type MoveValue struct {
+	Move  rules.Move
+	Value Value
+}
+
+// A Solver struct contains the values of all the positions of a variant reachable from the
+// empty board, started by X or O. The symmetric positions share a single value (see
+// rules.Board.Canonical).
+type Solver struct {
+	variant rules.Variant
+	values  map[uint32]Value // The values by canonical key of the positions
+}
+
+// New solves the variant. The variants limiting the symbols per player have no end and
+// return an error.
+func New(variant rules.Variant) (*Solver, error) {
+	variant = variant.Normalize()
+	if variant.MaxSymbols != 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedVariant, variant)
+	}
+	s := &Solver{variant: variant, values: map[uint32]Value{}}
+	for _, first := range []rules.Symbol{rules.X, rules.O} {
+		s.solve(rules.Board{}, first)
+	}
+	return s, nil
+}
+
+// Variant returns the variant solved.
+func (s *Solver) Variant() rules.Variant {
+	return s.variant
+}
+
+// Positions returns the number of positions solved, the symmetric positions counted once.
+func (s *Solver) Positions() int {
+	return len(s.values)
+}
+
+// solve returns the value of the position and stores it with the values of all the positions
+// reachable from it.
+func (s *Solver) solve(b rules.Board, turn rules.Symbol) Value {
+	key := canonicalKey(&b, turn)
+	if v, ok := s.values[key]; ok {
+		return v
+	}
+	v, over := s.terminal(&b, turn)
+	if !over {
+		for i, m := range s.moves(&b, turn) {
+			if value := s.play(b, turn, m); i == 0 || value.Better(v) {
+				v = value
+			}
+		}
+	}
+	s.values[key] = v
+	return v
+}
+
+// play returns the value of the move for the player who plays it.
+func (s *Solver) play(b rules.Board, turn rules.Symbol, m rules.Move) Value {
+	b[m.X][m.Y] = m.Symbol
+	next := s.solve(b, turn.Opponent())
+	return Value{Outcome: -next.Outcome, Distance: next.Distance + 1}
+}
+
+// terminal returns the value of a finished game for the player to move and true, or false if
+// the game goes on. A line was aligned by the last player.
+func (s *Solver) terminal(b *rules.Board, turn rules.Symbol) (Value, bool) {
+	if _, line := b.Winner(); line != nil {
+		if s.variant.Winner(turn.Opponent()) == turn {
+			return Value{Outcome: Win}, true
+		}
+		return Value{Outcome: Loss}, true
+	}
+	if b.Full() {
+		return Value{Outcome: Draw}, true
+	}
+	return Value{}, false
+}
+
+// moves returns the legal moves of the player in the order of the cells, [x][y].
+func (s *Solver) moves(b *rules.Board, turn rules.Symbol) []rules.Move {
+	var moves []rules.Move
+	for x := 0; x < 3; x++ {
+		for y := 0; y < 3; y++ {
+			if b[x][y] != rules.None {
+				continue
+			}
+			for _, symbol := range s.variant.Symbols(turn) {
+				moves = append(moves, rules.Move{X: x, Y: y, Symbol: symbol})
+			}
+		}
+	}
+	return moves
+}
+
+// Value returns the value of the position for the player to move, or an error if the position
+// cannot be reached in the variant.
+func (s *Solver) Value(b rules.Board, turn rules.Symbol) (Value, error) {
+	if !turn.Valid() {
+		return Value{}, fmt.Errorf("%w: %q", rules.ErrInvalidPlayer, turn)
+	}
+	v, ok := s.values[canonicalKey(&b, turn)]
+	if !ok {
+		return Value{}, ErrUnreachable
+	}
+	return v, nil
+}
+
+// Moves returns the legal moves of the player to move with their value, the best first and in
+// the order of the cells for equal values. A finished game has no move.
+func (s *Solver) Moves(b rules.Board, turn rules.Symbol) ([]MoveValue, error) {
+	if _, err := s.Value(b, turn); err != nil {
+		return nil, err
+	}
+	if _, over := s.terminal(&b, turn); over {
+		return nil, nil
+	}
+	var moves []MoveValue
+	for _, m := range s.moves(&b, turn) {
+		moves = append(moves, MoveValue{Move: m, Value: s.play(b, turn, m)})
+	}
+	sort.SliceStable(moves, func(i, j int) bool {
+		return moves[i].Value.Better(moves[j].Value)
+	})
+	return moves, nil
+}
+
+// canonicalKey returns the key of the canonical board of the position, the same for all the
+// symmetric positions, with its lowest bit set when O is to move.
+func canonicalKey(b *rules.Board, turn rules.Symbol) uint32 {
+	canonical, _ := b.Canonical()
+	key := canonical.Key() * 2
+	if turn == rules.O {
+		key++
+	}
+	return key
+}
+
+// ParsePosition parses a position written as its 9 cells row by row from the top left, X, O,
+// or "." for an empty cell, optionally followed by the player to move, as "X.O/.X./..O:O".
+// The slashes and spaces between the rows are ignored. Without a player, the player with
+// fewer symbols moves, X when both have as many, or every other move in the variants where
+// the players share the symbols.
+func ParsePosition(s string, variant rules.Variant) (rules.Board, rules.Symbol, error) {
+	var b rules.Board
+	cells, player, found := strings.Cut(strings.ToUpper(s), ":")
+	cells = strings.NewReplacer("/", "", " ", "", "|", "").Replace(cells)
+	if len(cells) != 9 {
+		return b, rules.None, fmt.Errorf("%w: %d cells instead of 9 in %q", ErrInvalidPosition, len(cells), s)
+	}
+	counts := map[rules.Symbol]int{}
+	for i, c := range cells {
+		symbol := rules.Symbol(c)
+		switch c {
+		case '.', '-', '_':
+			symbol = rules.None
+		case 'X', 'O':
+		default:
+			return b, rules.None, fmt.Errorf("%w: unknown cell %q in %q", ErrInvalidPosition, c, s)
+		}
+		b[i%3][i/3] = symbol
+		counts[symbol]++
+	}
+
+	turn := rules.Symbol(player)
+	switch {
+	case found && !turn.Valid():
+		return b, rules.None, fmt.Errorf("%w: %q", rules.ErrInvalidPlayer, player)
+	case found:
+	case variant.Wild || variant.Notakto:
+		turn = rules.X
+		if (counts[rules.X]+counts[rules.O])%2 == 1 {
+			turn = rules.O
+		}
+	case counts[rules.O] < counts[rules.X]:
+		turn = rules.O
+	default:
+		turn = rules.X
+	}
+	return b, turn, nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package solver
+
+import (
+	"GoRythm/internal/rules"
+	"errors"
+	"testing"
+)
+
+// TestNew tests the New function.
+// Checks if the classic game is a draw with 765 positions per first player, and if the symbol limit is refused.
+func TestNew(t *testing.T) {
+	s, err := New(rules.Variant{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if s.Positions() != 2*765 {
+		t.Errorf("Expected %d positions, got %d", 2*765, s.Positions())
+	}
+	for _, first := range []rules.Symbol{rules.X, rules.O} {
+		if v, err := s.Value(rules.Board{}, first); err != nil || v != (Value{Outcome: Draw, Distance: 9}) {
+			t.Errorf("Expected a draw in 9 moves for %s, got %v %v", first, v, err)
+		}
+	}
+	if _, err := New(rules.Variant{MaxSymbols: 3}); !errors.Is(err, ErrUnsupportedVariant) {
+		t.Errorf("Expected %v, got %v", ErrUnsupportedVariant, err)
+	}
+}
+
+// TestSolver_Value tests the Value function with the variants.
+// Checks the values of the empty board and if an unreachable position returns an error.
+func TestSolver_Value(t *testing.T) {
+	tests := map[string]struct {
+		variant rules.Variant
+		want    Outcome
+	}{
+		"misere":  {rules.Variant{Misere: true}, Draw},
+		"wild":    {rules.Variant{Wild: true}, Win},
+		"notakto": {rules.Variant{Notakto: true}, Win},
+	}
+	for name, test := range tests {
+		s, err := New(test.variant)
+		if err != nil {
+			t.Fatalf("Expected no error for %s, got %v", name, err)
+		}
+		if v, err := s.Value(rules.Board{}, rules.X); err != nil || v.Outcome != test.want {
+			t.Errorf("Expected a %s for %s, got %v %v", test.want, name, v, err)
+		}
+	}
+
+	s, _ := New(rules.Variant{})
+	three := rules.Board{{rules.X, rules.X, rules.X}}
+	if _, err := s.Value(three, rules.X); !errors.Is(err, ErrUnreachable) {
+		t.Errorf("Expected %v, got %v", ErrUnreachable, err)
+	}
+	if _, err := s.Value(rules.Board{}, rules.None); !errors.Is(err, rules.ErrInvalidPlayer) {
+		t.Errorf("Expected %v, got %v", rules.ErrInvalidPlayer, err)
+	}
+}
+
+// TestSolver_Moves tests the Moves function.
+// Checks if the winning move comes first, if the symmetric positions share their value and if a finished game has no move.
+func TestSolver_Moves(t *testing.T) {
+	s, _ := New(rules.Variant{})
+	// X completes the first column, O the second one
+	b := rules.Board{{rules.X, rules.X}, {rules.O, rules.O}}
+	moves, err := s.Moves(b, rules.X)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	want := MoveValue{Move: rules.Move{X: 0, Y: 2, Symbol: rules.X}, Value: Value{Outcome: Win, Distance: 1}}
+	if len(moves) != 5 || moves[0] != want {
+		t.Errorf("Expected %v first of 5 moves, got %v", want, moves)
+	}
+	if last := moves[len(moves)-1].Value; last != (Value{Outcome: Loss, Distance: 2}) {
+		t.Errorf("Expected the other moves to lose in 2, got %v", last)
+	}
+
+	mirrored := rules.Board{{}, {rules.O, rules.O}, {rules.X, rules.X}}
+	if v, _ := s.Value(mirrored, rules.X); v != want.Value {
+		t.Errorf("Expected the mirrored position to be a %v, got %v", want.Value, v)
+	}
+
+	b[0][2] = rules.X
+	if moves, err := s.Moves(b, rules.O); err != nil || moves != nil {
+		t.Errorf("Expected no move after the win, got %v %v", moves, err)
+	}
+	if v, _ := s.Value(b, rules.O); v != (Value{Outcome: Loss}) {
+		t.Errorf("Expected O to have lost, got %v", v)
+	}
+}
+
+// TestValue_Better tests the Better function.
+// Checks if a faster win and a slower loss are better.
+func TestValue_Better(t *testing.T) {
+	ordered := []Value{{Win, 1}, {Win, 3}, {Draw, 2}, {Loss, 4}, {Loss, 2}}
+	for i := 1; i < len(ordered); i++ {
+		if !ordered[i-1].Better(ordered[i]) || ordered[i].Better(ordered[i-1]) {
+			t.Errorf("Expected %v to be better than %v", ordered[i-1], ordered[i])
+		}
+	}
+	if s := (Value{Win, 3}).String(); s != "win in 3" {
+		t.Errorf("Expected %q, got %q", "win in 3", s)
+	}
+	if s := (Value{Loss, 2}).Short(); s != "L2" {
+		t.Errorf("Expected %q, got %q", "L2", s)
+	}
+}
+
+// TestParsePosition tests the ParsePosition function.
+// Checks the cells, the player to move with and without a player, and the invalid positions.
+func TestParsePosition(t *testing.T) {
+	b, turn, err := ParsePosition("X.O/.X./..o", rules.Variant{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if b[0][0] != rules.X || b[2][0] != rules.O || b[1][1] != rules.X || b[2][2] != rules.O || b[0][2] != rules.None {
+		t.Errorf("Expected the cells row by row, got %v", b)
+	}
+	if turn != rules.X {
+		t.Errorf("Expected X to move, got %q", turn)
+	}
+	if _, turn, _ := ParsePosition("X........", rules.Variant{}); turn != rules.O {
+		t.Errorf("Expected O to move, got %q", turn)
+	}
+	if _, turn, _ := ParsePosition("XX.......", rules.Variant{Notakto: true}); turn != rules.X {
+		t.Errorf("Expected X to move every other move in Notakto, got %q", turn)
+	}
+	if _, turn, _ := ParsePosition(".........:O", rules.Variant{}); turn != rules.O {
+		t.Errorf("Expected O to move, got %q", turn)
+	}
+	for _, invalid := range []string{"X.O", "X.O.X..Z.", ".........:Z"} {
+		if _, _, err := ParsePosition(invalid, rules.Variant{}); err == nil {
+			t.Errorf("Expected an error for %q", invalid)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !js
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// The directory of the stored files, the user config directory when empty
+var dir string
+
+// SetDir changes the directory of the stored files.
+func SetDir(d string) {
+	dir = d
+}
+
+// Dir returns the directory of the stored files.
+func Dir() (string, error) {
+	if dir != "" {
+		return dir, nil
+	}
+	config, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the config directory: %w", err)
+	}
+	return filepath.Join(config, appName), nil
+}
+
+// Load returns the data stored under the given name, or ErrNotFound.
+func Load(name string) ([]byte, error) {
+	d, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(d, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to load %s: %w", name, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", name, err)
+	}
+	return data, nil
+}
+
+// Save stores the data under the given name. The file is replaced atomically.
+func Save(name string, data []byte) error {
+	d, err := Dir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(d, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", d, err)
+	}
+	tmp, err := os.CreateTemp(d, name+".*")
+	if err != nil {
+		return fmt.Errorf("failed to save %s: %w", name, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to save %s: %w", name, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save %s: %w", name, err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(d, name)); err != nil {
+		return fmt.Errorf("failed to save %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !js
+
+package storage
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+)
+
+// TestSave tests the Save and Load functions in a temporary directory.
+// Checks if the saved data are loaded back and replaced by the next save.
+func TestSave(t *testing.T) {
+	SetDir(t.TempDir())
+	defer SetDir("")
+
+	if err := Save("test.json", []byte("first")); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := Save("test.json", []byte("second")); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	data, err := Load("test.json")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(data) != "second" {
+		t.Errorf("Expected second, got %s", data)
+	}
+}
+
+// TestLoad tests the Load function with missing data.
+// Checks if ErrNotFound is returned.
+func TestLoad(t *testing.T) {
+	SetDir(t.TempDir())
+	defer SetDir("")
+
+	if _, err := Load("missing.json"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+// TestDir tests the Dir function with the XDG config directory.
+// Checks if the files are stored in the GoRythm directory of the user config.
+func TestDir(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The config directory is given by %AppData% on Windows")
+	}
+	t.Setenv("XDG_CONFIG_HOME", "/tmp/config")
+	t.Setenv("HOME", "/tmp/home")
+	d, err := Dir()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if d != "/tmp/config/GoRythm" && d != "/tmp/home/Library/Application Support/GoRythm" {
+		t.Errorf("Expected the GoRythm config directory, got %s", d)
+	}
+}
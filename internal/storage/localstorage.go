@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build js
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"syscall/js"
+)
+
+// The prefix of the localStorage keys
+var dir = appName
+
+// SetDir changes the prefix of the localStorage keys.
+func SetDir(d string) {
+	dir = d
+}
+
+// Dir returns the prefix of the localStorage keys.
+func Dir() (string, error) {
+	return dir, nil
+}
+
+// localStorage returns the localStorage of the browser.
+func localStorage() (js.Value, error) {
+	storage := js.Global().Get("localStorage")
+	if storage.IsUndefined() || storage.IsNull() {
+		return js.Value{}, errors.New("localStorage is not available")
+	}
+	return storage, nil
+}
+
+// Load returns the data stored under the given name, or ErrNotFound.
+func Load(name string) ([]byte, error) {
+	storage, err := localStorage()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", name, err)
+	}
+	value := storage.Call("getItem", dir+"/"+name)
+	if value.IsNull() {
+		return nil, fmt.Errorf("failed to load %s: %w", name, ErrNotFound)
+	}
+	return []byte(value.String()), nil
+}
+
+// Save stores the data under the given name.
+func Save(name string, data []byte) (err error) {
+	storage, err := localStorage()
+	if err != nil {
+		return fmt.Errorf("failed to save %s: %w", name, err)
+	}
+	// setItem throws when the quota is exceeded
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to save %s: %v", name, r)
+		}
+	}()
+	storage.Call("setItem", dir+"/"+name, string(data))
+	return nil
+}
@@ -0,0 +1,18 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package storage provides the persistent storage of the user data of GoRythm (settings,
+// scores, ...). The data are stored in files of the user config directory on desktop
+// ($XDG_CONFIG_HOME/GoRythm on Linux) and in the localStorage of the browser in the WASM build.
+package storage
+
+import (
+	"errors"
+)
+
+const (
+	appName = "GoRythm" // The directory or key prefix of the stored data
+)
+
+// ErrNotFound is returned when loading data that were never saved.
+var ErrNotFound = errors.New("not found")
@@ -7,15 +7,18 @@ package theme
 import "image/color"
 
 var (
-	BackgroundColor         color.Color = color.Black                            // Black
-	TextColor               color.Color = color.White                            // White
-	SelectedTextColor       color.Color = color.RGBA{R: 255, G: 0, B: 0, A: 255} // Red
-	BoardColor              color.Color = color.White                            // White
-	CircleNoBeatColor       color.Color = color.RGBA{R: 0, G: 0, B: 255, A: 255} // Blue
-	CircleBeatColor         color.Color = color.RGBA{R: 255, G: 0, B: 0, A: 255} // Red
-	WinningLineColor        color.Color = color.RGBA{R: 255, G: 0, B: 0, A: 255} // Red
-	GameOverTextColor       color.Color = color.RGBA{G: 50, B: 200, A: 255}      // Dark blue
-	ToBeDeletedSymbolsColor color.Color = color.RGBA{82, 82, 82, 255}            // Grey
-	SymbolXColor            color.Color = color.White                            // White
-	SymbolOColor            color.Color = color.White                            // White
+	BackgroundColor         color.Color = color.Black                             // Black
+	TextColor               color.Color = color.White                             // White
+	SelectedTextColor       color.Color = color.RGBA{R: 255, G: 0, B: 0, A: 255}  // Red
+	BoardColor              color.Color = color.White                             // White
+	CircleNoBeatColor       color.Color = color.RGBA{R: 0, G: 0, B: 255, A: 255}  // Blue
+	CircleBeatColor         color.Color = color.RGBA{R: 255, G: 0, B: 0, A: 255}  // Red
+	WinningLineColor        color.Color = color.RGBA{R: 255, G: 0, B: 0, A: 255}  // Red
+	GameOverTextColor       color.Color = color.RGBA{G: 50, B: 200, A: 255}       // Dark blue
+	ToBeDeletedSymbolsColor color.Color = color.RGBA{82, 82, 82, 255}             // Grey
+	SymbolXColor            color.Color = color.White                             // White
+	SymbolOColor            color.Color = color.White                             // White
+	ActiveBoardColor        color.Color = color.RGBA{R: 30, G: 30, B: 90, A: 255} // Dark blue
+	WinValueColor           color.Color = color.RGBA{G: 200, A: 255}              // Green
+	LossValueColor          color.Color = color.RGBA{R: 255, G: 120, A: 255}      // Orange
 )
@@ -0,0 +1,275 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tournament runs round-robin tournaments between the AI strategies. Every pair of
+// strategies plays seeded games in each mode, alternating the first player, and the results
+// are reported as win/draw/loss tables with Elo estimates.
+package tournament
+
+import (
+	"GoRythm/internal/ai"
+	"GoRythm/internal/cube"
+	"GoRythm/internal/rules"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	DefaultMaxMoves = 200  // The number of moves after which a game is a draw, the games with a symbol limit can be endless
+	BaseElo         = 1500 // The mean Elo of the players
+
+	eloIterations = 1000 // The maximum number of iterations of the Elo estimation
+	eloPrecision  = 1e-9 // The change below which the Elo estimation stops
+)
+
+var (
+	ErrUnknownMode   = errors.New("unknown mode")
+	ErrTooFewPlayers = errors.New("a tournament needs at least two strategies")
+)
+
+// A Mode struct contains the name of a game mode and creates its positions.
+type Mode struct {
+	Name string
+	New  func(first rules.Symbol) (ai.Position, error)
+}
+
+// ParseMode returns the mode of the name: classic, ultimate, cube3 or cube4. The classic
+// games are played with the rule variant.
+func ParseMode(name string, variant rules.Variant) (Mode, error) {
+	switch name {
+	case "classic":
+		return Mode{name, func(first rules.Symbol) (ai.Position, error) {
+			return ai.NewClassic(first, variant), nil
+		}}, nil
+	case "ultimate":
+		return Mode{name, func(first rules.Symbol) (ai.Position, error) {
+			return ai.NewUltimate(first), nil
+		}}, nil
+	}
+	for size := cube.MinSize; size <= cube.MaxSize; size++ {
+		if name == fmt.Sprintf("cube%d", size) {
+			return Mode{name, func(first rules.Symbol) (ai.Position, error) {
+				return ai.NewCube(size, first)
+			}}, nil
+		}
+	}
+	return Mode{}, fmt.Errorf("%w: %q", ErrUnknownMode, name)
+}
+
+// A Config struct contains the settings of a tournament.
+type Config struct {
+	Players  []string // The names of the registered strategies
+	Modes    []Mode   // The modes played
+	Games    int      // The number of games of each pair of players in each mode
+	Seed     int64    // The seed of the first game, the next games use the following seeds
+	MaxMoves int      // The number of moves after which a game is a draw, DefaultMaxMoves if 0
+}
+
+// A Record struct contains the results of a player against an opponent.
+type Record struct {
+	Wins, Draws, Losses int
+}
+
+// Games returns the number of games of the record.
+func (r Record) Games() int {
+	return r.Wins + r.Draws + r.Losses
+}
+
+// Points returns the points of the record: one per win and a half per draw.
+func (r Record) Points() float64 {
+	return float64(r.Wins) + float64(r.Draws)/2
+}
+
+// A Table struct contains the results of the players in a mode.
+// Records[i][j] is the record of the player i against the player j.
+type Table struct {
+	Mode    string
+	Records [][]Record
+}
+
+// A Report struct contains the results of a tournament.
+type Report struct {
+	Players []string   // The names of the players
+	Tables  []Table    // The results in each mode
+	Total   [][]Record // The results in all modes
+	Elo     []float64  // The Elo estimate of each player over all modes
+}
+
+// Run plays the tournament: each pair of players plays the configured number of games in each
+// mode, the first game of a pair is started by the first player and the next ones alternate.
+func Run(config Config) (*Report, error) {
+	if len(config.Players) < 2 {
+		return nil, ErrTooFewPlayers
+	}
+	maxMoves := config.MaxMoves
+	if maxMoves <= 0 {
+		maxMoves = DefaultMaxMoves
+	}
+	strategies := make([]ai.Strategy, len(config.Players))
+	for i, name := range config.Players {
+		s, err := ai.New(name)
+		if err != nil {
+			return nil, err
+		}
+		strategies[i] = s
+	}
+
+	n := len(config.Players)
+	report := &Report{Players: config.Players, Total: newRecords(n)}
+	seed := config.Seed
+	for _, mode := range config.Modes {
+		table := Table{Mode: mode.Name, Records: newRecords(n)}
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				for game := 0; game < config.Games; game++ {
+					// The players alternate X, which starts the game
+					x, o := i, j
+					if game%2 == 1 {
+						x, o = j, i
+					}
+					p, err := mode.New(rules.X)
+					if err != nil {
+						return nil, err
+					}
+					r := rand.New(rand.NewSource(seed))
+					seed++
+					winner, err := Play(p, strategies[x], strategies[o], r, maxMoves)
+					if err != nil {
+						return nil, fmt.Errorf("%s: %s against %s: %w", mode.Name, config.Players[x], config.Players[o], err)
+					}
+					for _, records := range [][][]Record{table.Records, report.Total} {
+						switch winner {
+						case rules.X:
+							addWin(records, x, o)
+						case rules.O:
+							addWin(records, o, x)
+						default:
+							addDraw(records, x, o)
+						}
+					}
+				}
+			}
+		}
+		report.Tables = append(report.Tables, table)
+	}
+	report.Elo = EstimateElo(report.Total)
+	return report, nil
+}
+
+// Play plays a game between the strategies from the position and returns the winner, or None
+// on a draw or after the maximum number of moves.
+func Play(p ai.Position, x, o ai.Strategy, r *rand.Rand, maxMoves int) (rules.Symbol, error) {
+	for moves := 0; !p.Over() && moves < maxMoves; moves++ {
+		s := x
+		if p.Turn() == rules.O {
+			s = o
+		}
+		move, err := s.Move(p, r)
+		if err != nil {
+			return rules.None, err
+		}
+		if err := p.Play(move); err != nil {
+			return rules.None, err
+		}
+	}
+	return p.Winner(), nil
+}
+
+// newRecords returns an empty table of records of n players.
+func newRecords(n int) [][]Record {
+	records := make([][]Record, n)
+	for i := range records {
+		records[i] = make([]Record, n)
+	}
+	return records
+}
+
+// addWin adds a win of the winner against the loser to the records.
+func addWin(records [][]Record, winner, loser int) {
+	records[winner][loser].Wins++
+	records[loser][winner].Losses++
+}
+
+// addDraw adds a draw between the players to the records.
+func addDraw(records [][]Record, a, b int) {
+	records[a][b].Draws++
+	records[b][a].Draws++
+}
+
+// EstimateElo returns the Elo ratings of the players best explaining their records, with a mean
+// of BaseElo. A virtual draw is added between each pair of players who played, so that the
+// ratings stay finite when a player wins all its games.
+func EstimateElo(records [][]Record) []float64 {
+	n := len(records)
+	// Bradley-Terry strengths estimated with the minorization-maximization algorithm
+	strengths := make([]float64, n)
+	for i := range strengths {
+		strengths[i] = 1
+	}
+	for iteration := 0; iteration < eloIterations; iteration++ {
+		change := 0.0
+		for i := 0; i < n; i++ {
+			points, weight := 0.0, 0.0
+			for j := 0; j < n; j++ {
+				games := float64(records[i][j].Games())
+				if i == j || games == 0 {
+					continue
+				}
+				points += records[i][j].Points() + 0.5
+				weight += (games + 1) / (strengths[i] + strengths[j])
+			}
+			if weight == 0 {
+				continue
+			}
+			next := points / weight
+			change = math.Max(change, math.Abs(next-strengths[i]))
+			strengths[i] = next
+		}
+		if change < eloPrecision {
+			break
+		}
+	}
+
+	elo := make([]float64, n)
+	mean := 0.0
+	for i, strength := range strengths {
+		elo[i] = 400 * math.Log10(strength)
+		mean += elo[i] / float64(n)
+	}
+	for i := range elo {
+		elo[i] += BaseElo - mean
+	}
+	return elo
+}
+
+// Write writes the win/draw/loss tables of the report, each cell is the record of the player of
+// the row against the player of the column, followed by the Elo estimates.
+func (r *Report) Write(w io.Writer) error {
+	tables := append(append([]Table{}, r.Tables...), Table{Mode: "total", Records: r.Total})
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, table := range tables {
+		fmt.Fprintf(tw, "%s (W/D/L)\t%s\t\n", table.Mode, strings.Join(r.Players, "\t"))
+		for i, player := range r.Players {
+			cells := make([]string, len(r.Players))
+			for j := range r.Players {
+				cells[j] = "-"
+				if i != j {
+					record := table.Records[i][j]
+					cells[j] = fmt.Sprintf("%d/%d/%d", record.Wins, record.Draws, record.Losses)
+				}
+			}
+			fmt.Fprintf(tw, "%s\t%s\t\n", player, strings.Join(cells, "\t"))
+		}
+		fmt.Fprintln(tw)
+	}
+	fmt.Fprintln(tw, "Elo\t\t")
+	for i, player := range r.Players {
+		fmt.Fprintf(tw, "%s\t%.0f\t\n", player, r.Elo[i])
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package tournament
+
+import (
+	"GoRythm/internal/ai"
+	"GoRythm/internal/rules"
+	"bytes"
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestParseMode tests the ParseMode function.
+// Checks if the modes create positions of the right kind and if an unknown mode returns an error.
+func TestParseMode(t *testing.T) {
+	for name, want := range map[string]ai.Position{"classic": &ai.Classic{}, "ultimate": &ai.Ultimate{}, "cube3": &ai.Cube{}, "cube4": &ai.Cube{}} {
+		mode, err := ParseMode(name, rules.Variant{})
+		if err != nil {
+			t.Fatalf("Expected no error for %q, got %v", name, err)
+		}
+		p, err := mode.New(rules.X)
+		if err != nil || reflect.TypeOf(p) != reflect.TypeOf(want) {
+			t.Errorf("Expected a %T for %q, got %T %v", want, name, p, err)
+		}
+	}
+	if _, err := ParseMode("cube5", rules.Variant{}); !errors.Is(err, ErrUnknownMode) {
+		t.Errorf("Expected %v, got %v", ErrUnknownMode, err)
+	}
+}
+
+// TestPlay tests the Play function.
+// Checks if two minimax players draw a classic game and if the game stops after the maximum number of moves.
+func TestPlay(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	winner, err := Play(ai.NewClassic(rules.X, rules.Variant{}), ai.Minimax{}, ai.Minimax{}, r, DefaultMaxMoves)
+	if err != nil || winner != rules.None {
+		t.Errorf("Expected a draw, got %q %v", winner, err)
+	}
+
+	p := ai.NewClassic(rules.X, rules.Variant{MaxSymbols: 3})
+	if _, err := Play(p, ai.Random{}, ai.Random{}, r, 4); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if p.Game.Rounds != 4 {
+		t.Errorf("Expected 4 moves, got %d", p.Game.Rounds)
+	}
+}
+
+// TestRun tests the Run function.
+// Checks if minimax never loses against random, is rated higher, and if the same seed gives the same report.
+func TestRun(t *testing.T) {
+	classic, _ := ParseMode("classic", rules.Variant{})
+	config := Config{Players: []string{"random", "minimax"}, Modes: []Mode{classic}, Games: 10, Seed: 42}
+	report, err := Run(config)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	minimax := report.Tables[0].Records[1][0]
+	if minimax.Games() != 10 || minimax.Losses != 0 || minimax.Wins == 0 {
+		t.Errorf("Expected minimax to win without losing 10 games, got %+v", minimax)
+	}
+	if report.Total[0][1].Wins != minimax.Losses || report.Total[0][1].Losses != minimax.Wins {
+		t.Errorf("Expected the records to be symmetric, got %+v and %+v", report.Total[0][1], minimax)
+	}
+	if report.Elo[1] <= report.Elo[0] {
+		t.Errorf("Expected minimax to be rated higher, got %v", report.Elo)
+	}
+
+	again, _ := Run(config)
+	if !reflect.DeepEqual(report, again) {
+		t.Error("Expected the same report with the same seed")
+	}
+
+	if _, err := Run(Config{Players: []string{"random"}}); !errors.Is(err, ErrTooFewPlayers) {
+		t.Errorf("Expected %v, got %v", ErrTooFewPlayers, err)
+	}
+	if _, err := Run(Config{Players: []string{"random", "nope"}}); !errors.Is(err, ai.ErrUnknownStrategy) {
+		t.Errorf("Expected %v, got %v", ai.ErrUnknownStrategy, err)
+	}
+}
+
+// TestEstimateElo tests the EstimateElo function.
+// Checks if even records give equal ratings and if uneven records give the expected difference.
+func TestEstimateElo(t *testing.T) {
+	even := [][]Record{{{}, {Wins: 2, Losses: 2}}, {{Wins: 2, Losses: 2}, {}}}
+	if elo := EstimateElo(even); math.Abs(elo[0]-BaseElo) > 1e-6 || math.Abs(elo[1]-BaseElo) > 1e-6 {
+		t.Errorf("Expected %d for both players, got %v", BaseElo, elo)
+	}
+
+	// With the virtual draw, the score is 3.5 to 1.5 in 5 games
+	uneven := [][]Record{{{}, {Wins: 3, Losses: 1}}, {{Wins: 1, Losses: 3}, {}}}
+	elo := EstimateElo(uneven)
+	want := 400 * math.Log10(3.5/1.5)
+	if math.Abs(elo[0]-elo[1]-want) > 0.01 {
+		t.Errorf("Expected a difference of %.2f, got %v", want, elo)
+	}
+}
+
+// TestReport_Write tests the Write function.
+// Checks if the tables of each mode, the total and the Elo ratings are written.
+func TestReport_Write(t *testing.T) {
+	report := &Report{
+		Players: []string{"a", "b"},
+		Tables:  []Table{{Mode: "classic", Records: [][]Record{{{}, {Wins: 1, Draws: 2}}, {{Draws: 2, Losses: 1}, {}}}}},
+		Total:   [][]Record{{{}, {Wins: 1, Draws: 2}}, {{Draws: 2, Losses: 1}, {}}},
+		Elo:     []float64{1550, 1450},
+	}
+	var buf bytes.Buffer
+	if err := report.Write(&buf); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for _, want := range []string{"classic (W/D/L)", "total (W/D/L)", "1/2/0", "0/2/1", "1550", "1450"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Expected %q in the report, got:\n%s", want, buf.String())
+		}
+	}
+}
+
+// TestRun_levels tests the Run function with the skill levels of the AI.
+// Checks if each level wins more games than it loses against the level below.
+func TestRun_levels(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping the calibration of the levels in short mode")
+	}
+	classic, _ := ParseMode("classic", rules.Variant{})
+	for level := ai.MinLevel + 1; level <= ai.MaxLevel; level++ {
+		config := Config{Players: []string{ai.LevelName(level - 1), ai.LevelName(level)}, Modes: []Mode{classic}, Games: 40, Seed: 1}
+		report, err := Run(config)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if record := report.Total[1][0]; record.Wins <= record.Losses {
+			t.Errorf("Expected %s to beat %s, got %+v", config.Players[1], config.Players[0], record)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"bufio"
+)
+
+// A Key type represents a key read from the terminal: the named keys below or a printable
+// character.
+type Key string
+
+const (
+	KeyUp    Key = "up"
+	KeyDown  Key = "down"
+	KeyLeft  Key = "left"
+	KeyRight Key = "right"
+	KeyEnter Key = "enter"
+	KeySpace Key = "space"
+	KeyQuit  Key = "quit" // Ctrl-C or Ctrl-D
+	KeyNone  Key = ""     // A key without action, as an escape sequence not handled
+)
+
+// The final bytes of the escape sequences of the arrow keys
+var arrows = map[byte]Key{
+	'A': KeyUp,
+	'B': KeyDown,
+	'C': KeyRight,
+	'D': KeyLeft,
+}
+
+// ReadKey reads the next key. In raw mode, Enter is read as a carriage return, the line feeds
+// ending the lines of a script are ignored.
+func ReadKey(r *bufio.Reader) (Key, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return KeyNone, err
+	}
+	switch b {
+	case '\r':
+		return KeyEnter, nil
+	case '\n':
+		return KeyNone, nil
+	case ' ':
+		return KeySpace, nil
+	case 0x03, 0x04:
+		return KeyQuit, nil
+	case 0x1b:
+		return readEscape(r)
+	}
+	return Key(b), nil
+}
+
+// readEscape reads the rest of an escape sequence, as "ESC [ A" for the up arrow. An escape
+// key alone has no action.
+func readEscape(r *bufio.Reader) (Key, error) {
+	if r.Buffered() == 0 {
+		return KeyNone, nil
+	}
+	if b, _ := r.Peek(1); b[0] != '[' && b[0] != 'O' {
+		return KeyNone, nil
+	}
+	r.ReadByte()
+	// The parameters of the sequence are skipped until its final byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return KeyNone, err
+		}
+		if b >= 0x40 && b <= 0x7e {
+			return arrows[b], nil
+		}
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tui plays the 3x3 game in a terminal, between two players sharing the keyboard or
+// against an AI strategy. It reads the keys from a reader and draws the board with ANSI escape
+// sequences, or as plain text for the scripts and the tests.
+package tui
+
+import (
+	"GoRythm/internal/ai"
+	"GoRythm/internal/rules"
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+const (
+	AIDeadline = 2 * time.Second // The time the AI has to choose its move
+)
+
+// The ANSI escape sequences of the drawing
+const (
+	clearScreen = "\x1b[H\x1b[2J"
+	reset       = "\x1b[0m"
+	faint       = "\x1b[2m"
+	reverse     = "\x1b[7m"
+	cyan        = "\x1b[36m"
+	yellow      = "\x1b[33m"
+	green       = "\x1b[1;32m"
+)
+
+// The cells played by the number keys, laid out as a numeric keypad
+var numberToBoard = map[Key][2]int{
+	"7": {0, 0}, "8": {1, 0}, "9": {2, 0},
+	"4": {0, 1}, "5": {1, 1}, "6": {2, 1},
+	"1": {0, 2}, "2": {1, 2}, "3": {2, 2},
+}
+
+// The directions the cursor is moved in by the arrows and the vi keys
+var cursorMoves = map[Key][2]int{
+	KeyUp: {0, -1}, KeyDown: {0, 1}, KeyLeft: {-1, 0}, KeyRight: {1, 0},
+	"k": {0, -1}, "j": {0, 1}, "h": {-1, 0}, "l": {1, 0},
+}
+
+// A Config struct contains the settings of the games played in the terminal.
+type Config struct {
+	Strategy string        // The registered strategy of the AI, empty for two human players
+	Human    rules.Symbol  // The symbol played by the human against the AI, X if empty
+	Variant  rules.Variant // The rule variants of the games
+	Color    bool          // Whether the screen is cleared and colored with ANSI escape sequences
+	Seed     int64         // The seed of the random numbers of the AI
+}
+
+// An App struct contains the state of the games played in the terminal.
+type App struct {
+	config  Config
+	player  ai.AIPlayer // The AI opponent, nil for two human players
+	out     io.Writer
+	game    *rules.Game
+	first   rules.Symbol         // The first player of the current game, alternating between games
+	cursor  [2]int               // The cell selected with the arrows
+	symbol  rules.Symbol         // The symbol placed by the next Wild move
+	score   map[rules.Symbol]int // The games won by each player, the draws under None
+	message string               // The message shown below the board, as a refused move
+}
+
+// New creates the application writing its screens to out, with a first game started by X.
+func New(config Config, out io.Writer) (*App, error) {
+	if config.Human == rules.None {
+		config.Human = rules.X
+	}
+	if !config.Human.Valid() {
+		return nil, fmt.Errorf("%w: %q", rules.ErrInvalidPlayer, config.Human)
+	}
+	config.Variant = config.Variant.Normalize()
+	a := &App{config: config, out: out, first: rules.X, score: make(map[rules.Symbol]int)}
+	if config.Strategy != "" {
+		player, err := ai.NewPlayer(config.Strategy, rand.New(rand.NewSource(config.Seed)))
+		if err != nil {
+			return nil, err
+		}
+		a.player = player
+	}
+	a.newGame()
+	return a, nil
+}
+
+// Run plays the games with the keys of the reader until the quit key or the end of the reader.
+func (a *App) Run(in io.Reader) error {
+	keys := bufio.NewReader(in)
+	if err := a.playAI(); err != nil {
+		return err
+	}
+	for {
+		if err := a.draw(); err != nil {
+			return err
+		}
+		key, err := ReadKey(keys)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if key == KeyQuit || key == "q" {
+			return nil
+		}
+		a.handle(key)
+		if err := a.playAI(); err != nil {
+			return err
+		}
+	}
+}
+
+// newGame starts a new game with the cursor at the center.
+func (a *App) newGame() {
+	a.game = rules.NewGame(a.first, a.config.Variant)
+	a.cursor = [2]int{1, 1}
+	a.symbol = rules.X
+	a.message = ""
+}
+
+// handle runs the action of the key.
+func (a *App) handle(key Key) {
+	a.message = ""
+	if key == "n" {
+		// The first player alternates as in a series
+		a.first = a.first.Opponent()
+		a.newGame()
+		return
+	}
+	if a.game.Over() {
+		a.message = "Press n for a new game"
+		return
+	}
+	if direction, ok := cursorMoves[key]; ok {
+		a.cursor[0] = min(max(a.cursor[0]+direction[0], 0), 2)
+		a.cursor[1] = min(max(a.cursor[1]+direction[1], 0), 2)
+		return
+	}
+	if cell, ok := numberToBoard[key]; ok {
+		a.cursor = cell
+		a.play(cell)
+		return
+	}
+	switch {
+	case key == KeySpace && a.config.Variant.Wild:
+		a.symbol = a.symbol.Opponent()
+	case key == KeyEnter || key == KeySpace:
+		a.play(a.cursor)
+	}
+}
+
+// play plays the cell for the human player to move, with the Wild symbol chosen.
+func (a *App) play(cell [2]int) {
+	symbol := a.config.Variant.Symbols(a.game.Turn)[0]
+	if a.config.Variant.Wild {
+		symbol = a.symbol
+	}
+	if _, err := a.game.Play(rules.Move{X: cell[0], Y: cell[1], Symbol: symbol}); err != nil {
+		a.message = "Refused: " + err.Error()
+		return
+	}
+	a.endGame()
+}
+
+// playAI plays the move of the AI while it is its turn.
+func (a *App) playAI() error {
+	if a.player == nil || a.game.Over() || a.game.Turn == a.config.Human {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), AIDeadline)
+	defer cancel()
+	move, err := a.player.Move(ctx, &ai.Classic{Game: a.game.Clone()}, a.game.Turn)
+	if err != nil {
+		return fmt.Errorf("%s: %w", a.config.Strategy, err)
+	}
+	if _, err := a.game.Play(ai.ClassicCell(move)); err != nil {
+		return fmt.Errorf("%s: %w", a.config.Strategy, err)
+	}
+	a.endGame()
+	return nil
+}
+
+// endGame counts the result of the game once it is over.
+func (a *App) endGame() {
+	if a.game.Over() {
+		winner, _ := a.game.Winner()
+		a.score[winner]++
+	}
+}
+
+// name returns the name of the player of the symbol.
+func (a *App) name(player rules.Symbol) string {
+	if a.player == nil {
+		return string(player)
+	}
+	if player == a.config.Human {
+		return fmt.Sprintf("You (%s)", player)
+	}
+	return fmt.Sprintf("AI %s (%s)", a.config.Strategy, player)
+}
+
+// status returns the line describing the state of the game.
+func (a *App) status() string {
+	if !a.game.Over() {
+		status := a.name(a.game.Turn) + " to move"
+		if a.config.Variant.Wild {
+			status += ", placing " + string(a.symbol)
+		}
+		return status
+	}
+	if winner, _ := a.game.Winner(); winner != rules.None {
+		return a.name(winner) + " wins"
+	}
+	return "Draw"
+}
+
+// draw writes the screen: the rules, the board with the cursor, the status of the game, the
+// scores and the keys.
+func (a *App) draw() error {
+	var b strings.Builder
+	if a.config.Color {
+		b.WriteString(clearScreen)
+	}
+	opponent := "two players"
+	if a.player != nil {
+		opponent = "against " + a.config.Strategy
+	}
+	fmt.Fprintf(&b, "GoRythm, %s rules, %s\n\n", a.config.Variant, opponent)
+	b.WriteString(a.board())
+	fmt.Fprintf(&b, "\n%s\n", a.status())
+	fmt.Fprintf(&b, "Score: %s %d, %s %d, draws %d\n", a.name(rules.X), a.score[rules.X], a.name(rules.O), a.score[rules.O], a.score[rules.None])
+	if a.message != "" {
+		fmt.Fprintf(&b, "%s\n", a.message)
+	}
+	keys := "Arrows or hjkl move, Enter plays, 1-9 play a cell, n new game, q quits"
+	if a.config.Variant.Wild {
+		keys = "Space changes the symbol. " + keys
+	}
+	fmt.Fprintf(&b, "\n%s\n", keys)
+	if !a.config.Color {
+		b.WriteString("\n")
+	}
+	_, err := io.WriteString(a.out, b.String())
+	return err
+}
+
+// board returns the drawing of the board. Without colors, the cursor is drawn between brackets
+// and the symbol removed on the next move of its player in lower case.
+func (a *App) board() string {
+	_, line := a.game.Winner()
+	winning := make(map[[2]int]bool, len(line))
+	for _, cell := range line {
+		winning[cell] = true
+	}
+	vanishing := make(map[[2]int]bool)
+	for _, player := range []rules.Symbol{rules.X, rules.O} {
+		if cell, ok := a.game.NextRemoval(player); ok {
+			vanishing[cell] = true
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("    a   b   c\n")
+	for y := 0; y < 3; y++ {
+		if y > 0 {
+			b.WriteString("   ---+---+---\n")
+		}
+		fmt.Fprintf(&b, "%d  ", y+1)
+		for x := 0; x < 3; x++ {
+			if x > 0 {
+				b.WriteString("|")
+			}
+			cell := [2]int{x, y}
+			b.WriteString(a.cell(cell, winning[cell], vanishing[cell]))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// cell returns the drawing of a cell, three characters wide.
+func (a *App) cell(cell [2]int, winning, vanishing bool) string {
+	symbol := string(a.game.Board[cell[0]][cell[1]])
+	if symbol == "" {
+		symbol = "."
+	}
+	cursor := cell == a.cursor && !a.game.Over()
+	if !a.config.Color {
+		if vanishing {
+			symbol = strings.ToLower(symbol)
+		}
+		if cursor {
+			return "[" + symbol + "]"
+		}
+		return " " + symbol + " "
+	}
+
+	style := ""
+	switch {
+	case winning:
+		style = green
+	case symbol == string(rules.X):
+		style = cyan
+	case symbol == string(rules.O):
+		style = yellow
+	}
+	if vanishing {
+		style += faint
+	}
+	if cursor {
+		style += reverse
+	}
+	if style == "" {
+		return " " + symbol + " "
+	}
+	return style + " " + symbol + " " + reset
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"GoRythm/internal/rules"
+	"bufio"
+	"strings"
+	"testing"
+)
+
+// run plays the keys with the configuration and returns the last screen drawn.
+func run(t *testing.T, config Config, keys string) (*App, string) {
+	t.Helper()
+	var out strings.Builder
+	a, err := New(config, &out)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := a.Run(strings.NewReader(keys)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	screens := strings.Split(strings.TrimSpace(out.String()), "GoRythm, ")
+	return a, screens[len(screens)-1]
+}
+
+// TestApp_Run tests the Run function.
+// Checks if two players play until X aligns the middle row, and the score is counted once.
+func TestApp_Run(t *testing.T) {
+	a, screen := run(t, Config{}, "5162x4\n9q3")
+	if !strings.Contains(screen, "X wins") {
+		t.Errorf("Expected X to win, got\n%s", screen)
+	}
+	if !strings.Contains(screen, "2   X | X | X") {
+		t.Errorf("Expected the middle row of X, got\n%s", screen)
+	}
+	if !strings.Contains(screen, "Press n for a new game") || a.score[rules.X] != 1 {
+		t.Errorf("Expected one win of X and the game over, got %v\n%s", a.score, screen)
+	}
+
+	// The second game is started by O, the cursor is moved up and left from the center
+	_, screen = run(t, Config{}, "5162x4n\x1b[A\x1b[D\r")
+	if !strings.Contains(screen, "1  [O]| . |") || !strings.Contains(screen, "X to move") {
+		t.Errorf("Expected O played in the corner by the cursor, got\n%s", screen)
+	}
+}
+
+// TestApp_Run_ai tests the Run function against the AI.
+// Checks if the AI answers each move and minimax never loses a game.
+func TestApp_Run_ai(t *testing.T) {
+	a, screen := run(t, Config{Strategy: "minimax", Human: rules.O}, "1379")
+	if a.game.Turn != rules.O && !a.game.Over() {
+		t.Errorf("Expected the AI to answer, got\n%s", screen)
+	}
+	if !strings.Contains(screen, "AI minimax (X)") {
+		t.Errorf("Expected the AI to play X, got\n%s", screen)
+	}
+	if a.score[rules.O] != 0 {
+		t.Errorf("Expected minimax not to lose, got %v", a.score)
+	}
+
+	if _, err := New(Config{Strategy: "chess"}, nil); err == nil {
+		t.Errorf("Expected an unknown strategy to be refused")
+	}
+}
+
+// TestApp_Run_wild tests the Run function with the Wild variant.
+// Checks if Space changes the symbol placed and the colors of the cursor are drawn.
+func TestApp_Run_wild(t *testing.T) {
+	a, screen := run(t, Config{Variant: rules.Variant{Wild: true}, Color: true}, " 5")
+	if a.game.Board[1][1] != rules.O {
+		t.Errorf("Expected O placed by X, got %v", a.game.Board)
+	}
+	if !strings.Contains(screen, yellow+reverse+" O "+reset) || !strings.Contains(screen, "O to move, placing O") {
+		t.Errorf("Expected a yellow O under the cursor, got %q", screen)
+	}
+}
+
+// TestReadKey tests the ReadKey function.
+// Checks the arrows, the control keys and the characters.
+func TestReadKey(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("\x1b[A\x1bOD\x1b[1;5C\r\n a\x03"))
+	want := []Key{KeyUp, KeyLeft, KeyRight, KeyEnter, KeyNone, KeySpace, "a", KeyQuit}
+	for _, w := range want {
+		if key, err := ReadKey(r); err != nil || key != w {
+			t.Errorf("Expected %q, got %q (%v)", w, key, err)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package ultimate
+
+import (
+	"GoRythm/internal/rules"
+)
+
+const (
+	DefaultDepth = 4 // The default search depth of the AI, in moves
+
+	winValue       = 100000 // The value of a won game
+	metaLineValue  = 100    // The value of a meta-board line with won sub-boards of only one player
+	boardValue     = 50     // The value of a won sub-board
+	centerValue    = 20     // The additional value of the won center sub-board
+	subLineValue   = 2      // The value of a sub-board line with symbols of only one player
+	unitValue      = 1      // The value of a line with a single symbol
+	twoInLineValue = 4      // The factor of a line with two symbols
+)
+
+// boardLines contains the indexes of the cells of the lines of a sub-board, see rules.Index.
+var boardLines = rules.Lines(3, 2)
+
+// Evaluate returns the heuristic value of the game for the player: the won sub-boards,
+// the open lines of the meta-board and the open lines of the sub-boards still played.
+func Evaluate(g *Game, player rules.Symbol) int {
+	if winner, _ := g.Winner(); winner != rules.None {
+		if winner == player {
+			return winValue
+		}
+		return -winValue
+	}
+	score := lineScore(&g.Meta, player, metaLineValue)
+	for bx := 0; bx < 3; bx++ {
+		for by := 0; by < 3; by++ {
+			switch g.Meta[bx][by] {
+			case player:
+				score += boardValue
+				if bx == 1 && by == 1 {
+					score += centerValue
+				}
+			case player.Opponent():
+				score -= boardValue
+				if bx == 1 && by == 1 {
+					score -= centerValue
+				}
+			case rules.None:
+				if !g.Decided(bx, by) {
+					score += lineScore(&g.Boards[bx][by], player, subLineValue)
+				}
+			}
+		}
+	}
+	return score
+}
+
+// lineScore returns the value of the lines of the board owned by only one player, positive
+// for the player and negative for its opponent. The value grows with the symbols in a line.
+func lineScore(b *rules.Board, player rules.Symbol, value int) int {
+	score := 0
+	for _, line := range boardLines {
+		mine, theirs := 0, 0
+		for _, index := range line {
+			switch b.At(index) {
+			case player:
+				mine++
+			case player.Opponent():
+				theirs++
+			}
+		}
+		switch {
+		case theirs == 0 && mine == 1:
+			score += value * unitValue
+		case theirs == 0 && mine == 2:
+			score += value * twoInLineValue
+		case mine == 0 && theirs == 1:
+			score -= value * unitValue
+		case mine == 0 && theirs == 2:
+			score -= value * twoInLineValue
+		}
+	}
+	return score
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ultimate contains the rules of Ultimate Tic-Tac-Toe: nine sub-boards placed on
+// a meta-board. The cell played in a sub-board sends the opponent to the matching sub-board,
+// and a player wins by aligning three won sub-boards.
+package ultimate
+
+import (
+	"GoRythm/internal/rules"
+	"errors"
+)
+
+const (
+	Size = 9 // The number of cells on each side of the whole board
+)
+
+var (
+	ErrIllegalMove = errors.New("illegal move")
+	ErrGameOver    = errors.New("game over")
+)
+
+// A Move struct contains the position of a cell on the whole 9x9 board, indexed by [x][y].
+// The sub-board of the cell is (X/3, Y/3) and its position in the sub-board is (X%3, Y%3).
+type Move struct {
+	X, Y int
+}
+
+// Board returns the position of the sub-board of the move on the meta-board.
+func (m Move) Board() (int, int) {
+	return m.X / 3, m.Y / 3
+}
+
+// Cell returns the position of the move in its sub-board, which is also the position of
+// the sub-board the opponent is sent to.
+func (m Move) Cell() (int, int) {
+	return m.X % 3, m.Y % 3
+}
+
+// A Game struct contains the state of an Ultimate Tic-Tac-Toe game. It is copied by value.
+type Game struct {
+	Boards [3][3]rules.Board // The sub-boards, indexed by [x][y] on the meta-board
+	Meta   rules.Board       // The winner of each sub-board
+	Turn   rules.Symbol      // The player to move
+	Next   [2]int            // The sub-board the player must play in, {-1, -1} for any open sub-board
+	Rounds int               // The number of moves played
+}
+
+// New creates a new game started by the given player, who can play anywhere.
+func New(first rules.Symbol) *Game {
+	return &Game{Turn: first, Next: [2]int{-1, -1}}
+}
+
+// Cell returns the symbol at the position of the whole board.
+func (g *Game) Cell(x, y int) rules.Symbol {
+	return g.Boards[x/3][y/3][x%3][y%3]
+}
+
+// Decided returns true if the sub-board is won or full, no move can be played in it.
+func (g *Game) Decided(bx, by int) bool {
+	return g.Meta[bx][by] != rules.None || g.Boards[bx][by].Full()
+}
+
+// Active returns true if the current player can play in the sub-board.
+func (g *Game) Active(bx, by int) bool {
+	if g.Over() || g.Decided(bx, by) {
+		return false
+	}
+	return g.Next[0] < 0 || (g.Next[0] == bx && g.Next[1] == by)
+}
+
+// Legal returns true if the move can be played by the current player.
+func (g *Game) Legal(m Move) bool {
+	if m.X < 0 || m.X >= Size || m.Y < 0 || m.Y >= Size {
+		return false
+	}
+	bx, by := m.Board()
+	return g.Active(bx, by) && g.Cell(m.X, m.Y) == rules.None
+}
+
+// Moves returns the legal moves of the current player, in the order of the whole board.
+func (g *Game) Moves() []Move {
+	if g.Over() {
+		return nil
+	}
+	var active [3][3]bool
+	for bx := 0; bx < 3; bx++ {
+		for by := 0; by < 3; by++ {
+			active[bx][by] = !g.Decided(bx, by) && (g.Next[0] < 0 || (g.Next[0] == bx && g.Next[1] == by))
+		}
+	}
+	var moves []Move
+	for x := 0; x < Size; x++ {
+		for y := 0; y < Size; y++ {
+			if active[x/3][y/3] && g.Cell(x, y) == rules.None {
+				moves = append(moves, Move{x, y})
+			}
+		}
+	}
+	return moves
+}
+
+// Play plays the move for the current player, updates the meta-board and the sub-board
+// the opponent is sent to, and switches the player.
+func (g *Game) Play(m Move) error {
+	if g.Over() {
+		return ErrGameOver
+	}
+	if !g.Legal(m) {
+		return ErrIllegalMove
+	}
+	bx, by := m.Board()
+	cx, cy := m.Cell()
+	g.Boards[bx][by][cx][cy] = g.Turn
+	if winner, _ := g.Boards[bx][by].Winner(); winner != rules.None {
+		g.Meta[bx][by] = winner
+	}
+	g.Next = [2]int{cx, cy}
+	if g.Decided(cx, cy) {
+		g.Next = [2]int{-1, -1}
+	}
+	g.Turn = g.Turn.Opponent()
+	g.Rounds++
+	return nil
+}
+
+// Winner returns the player who aligned three won sub-boards and their positions on the
+// meta-board, or None and nil.
+func (g *Game) Winner() (rules.Symbol, [][2]int) {
+	return g.Meta.Winner()
+}
+
+// Over returns true if a player won or if no sub-board is open anymore.
+func (g *Game) Over() bool {
+	if winner, _ := g.Winner(); winner != rules.None {
+		return true
+	}
+	for bx := 0; bx < 3; bx++ {
+		for by := 0; by < 3; by++ {
+			if !g.Decided(bx, by) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 Elian Waeber & Valentin Roch
+// SPDX-License-Identifier: Apache-2.0
+
+package ultimate
+
+import (
+	"GoRythm/internal/rules"
+	"errors"
+	"testing"
+)
+
+// TestGame_Play tests the Play function.
+// Checks if a move sends the opponent to the matching sub-board and if other moves are illegal.
+func TestGame_Play(t *testing.T) {
+	g := New(rules.X)
+	if len(g.Moves()) != Size*Size {
+		t.Errorf("Expected %d moves at the start, got %d", Size*Size, len(g.Moves()))
+	}
+	if err := g.Play(Move{4, 2}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if g.Next != [2]int{1, 2} || g.Turn != rules.O {
+		t.Errorf("Expected O to play in the sub-board (1, 2), got %s in %v", g.Turn, g.Next)
+	}
+	if err := g.Play(Move{0, 0}); !errors.Is(err, ErrIllegalMove) {
+		t.Errorf("Expected %v, got %v", ErrIllegalMove, err)
+	}
+	for _, m := range g.Moves() {
+		if bx, by := m.Board(); bx != 1 || by != 2 {
+			t.Errorf("Expected only moves in the sub-board (1, 2), got %v", m)
+		}
+	}
+}
+
+// TestGame_Play_decided tests the Play function when the opponent is sent to a won sub-board.
+// Checks if the opponent can play in any open sub-board.
+func TestGame_Play_decided(t *testing.T) {
+	g := New(rules.X)
+	g.Boards[0][0] = rules.Board{{rules.X, rules.X, rules.X}}
+	g.Meta[0][0] = rules.X
+	if err := g.Play(Move{3, 3}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if g.Next != [2]int{-1, -1} {
+		t.Errorf("Expected O to play anywhere, got %v", g.Next)
+	}
+	if g.Active(0, 0) || !g.Active(2, 2) {
+		t.Error("Expected only the open sub-boards to be active")
+	}
+}
+
+// TestGame_Winner tests the Winner function.
+// Checks if three won sub-boards in a line win the game and if no move can be played after it.
+func TestGame_Winner(t *testing.T) {
+	g := New(rules.O)
+	g.Meta[0][0], g.Meta[1][1] = rules.O, rules.O
+	g.Boards[2][2] = rules.Board{{rules.O, rules.O, rules.None}}
+	if err := g.Play(Move{6, 8}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	winner, line := g.Winner()
+	if winner != rules.O || len(line) != 3 {
+		t.Errorf("Expected O to win with a line, got %s %v", winner, line)
+	}
+	if err := g.Play(Move{0, 4}); !errors.Is(err, ErrGameOver) {
+		t.Errorf("Expected %v, got %v", ErrGameOver, err)
+	}
+}